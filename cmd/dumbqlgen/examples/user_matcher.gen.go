@@ -3,6 +3,7 @@ package examples
 
 import (
 	"go.tomakado.io/dumbql/match"
+	"go.tomakado.io/dumbql/query"
 )
 
 // UserRouter is a generated Router implementation for User.
@@ -15,19 +16,24 @@ func NewUserMatcher() *match.StructMatcher {
 }
 
 // Route resolves a field path in the target User and returns the value.
-// It supports nested field access using dot notation (e.g., "address.city").
+// It supports nested field access using dot notation (e.g., "address.city") and indexed access
+// to slices, arrays and maps (e.g., "items[0].sku", "attributes[\"color\"]"). The first segment
+// of the path is resolved by the generated code, the rest is resolved with match.ResolveSegment.
 func (r *UserRouter) Route(target any, field string) (any, error) {
 	var (
 		cursor = target
-		err      error
+		root   = true
+		err    error
 	)
 
-	for field := range match.Path(field) {
-		if field == "" {
-			return nil, match.ErrFieldNotFound
+	for seg := range match.Segments(field) {
+		if root {
+			cursor, err = r.resolveField(cursor, seg)
+			root = false
+		} else {
+			cursor, err = match.ResolveSegment(cursor, seg)
 		}
 
-		cursor, err = r.resolveField(target, field)
 		if err != nil {
 			return nil, err
 		}
@@ -36,14 +42,18 @@ func (r *UserRouter) Route(target any, field string) (any, error) {
 	return cursor, nil
 }
 
-// resolveField handles resolving a direct field (no dots in the name)
-func (r *UserRouter) resolveField(target any, field string) (any, error) {
+// resolveField handles resolving a direct field of User
+func (r *UserRouter) resolveField(target any, seg query.Segment) (any, error) {
+	if seg.Kind != query.FieldSegment && seg.Kind != query.KeySegment {
+		return nil, match.ErrFieldNotFound
+	}
+
 	obj, err := r.normalizeUser(target)
 	if err != nil {
 		return nil, err
 	}
 
-	switch field {
+	switch seg.Name {
 	case "id":
 		return obj.ID, nil
 	case "name":
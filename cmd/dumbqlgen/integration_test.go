@@ -57,6 +57,31 @@ func TestGeneratedMatcher(t *testing.T) {
 			query: `NOT name = "Jane Doe"`,
 			want:  true,
 		},
+		{
+			name:  "Nested field",
+			query: `address.city = Anytown and address.zip != "00000"`,
+			want:  true,
+		},
+		{
+			name:  "Slice index",
+			query: `tags[0] = admin and tags[-1] = beta`,
+			want:  true,
+		},
+		{
+			name:  "Slice index out of range",
+			query: `tags[5] = admin`,
+			want:  false,
+		},
+		{
+			name:  "Map key",
+			query: `attributes["team"] = core`,
+			want:  true,
+		},
+		{
+			name:  "Missing map key",
+			query: `attributes["region"] = eu`,
+			want:  false,
+		},
 		{
 			name:  "Negative match",
 			query: `name = "Jane Doe" AND email = "john@example.com"`,
@@ -89,5 +114,7 @@ func getTestUser() testdata.TestUser {
 			State:  "CA",
 			Zip:    "12345",
 		},
+		Tags:       []string{"admin", "beta"},
+		Attributes: map[string]string{"team": "core"},
 	}
 }
@@ -7,12 +7,14 @@ import (
 //go:generate go run go.tomakado.io/dumbql/cmd/dumbqlgen -type TestUser -package .
 
 type TestUser struct {
-	ID        int64  `dumbql:"id"`
-	Name      string `dumbql:"name"`
-	Email     string `dumbql:"email"`
-	CreatedAt time.Time
-	Address   Address `dumbql:"address"`
-	Private   bool    `dumbql:"-"`
+	ID         int64  `dumbql:"id"`
+	Name       string `dumbql:"name"`
+	Email      string `dumbql:"email"`
+	CreatedAt  time.Time
+	Address    Address           `dumbql:"address"`
+	Tags       []string          `dumbql:"tags"`
+	Attributes map[string]string `dumbql:"attributes"`
+	Private    bool              `dumbql:"-"`
 }
 
 type Address struct {
@@ -26,8 +26,52 @@ func (q *Query) Validate(s schema.Schema) (query.Expr, error) {
 	return q.Expr.Validate(s)
 }
 
+// Clone returns a deep copy of the query, see query.Clone.
+func (q *Query) Clone() *Query {
+	return &Query{query.Clone(q.Expr)}
+}
+
+// Lint reports subexpressions of the query which never match or always match, see query.Lint.
+func (q *Query) Lint(opts ...query.AnalysisOption) []query.Warning {
+	return query.Lint(q.Expr, opts...)
+}
+
 // ToSql converts the Query into an SQL string, returning the SQL string, arguments slice,
 // and any potential error encountered.
 func (q *Query) ToSql() (string, []any, error) { //nolint:revive
 	return q.Expr.ToSql()
 }
+
+// MarshalJSON encodes the Query into the versioned JSON representation of its AST, see query.MarshalJSON.
+func (q *Query) MarshalJSON() ([]byte, error) {
+	return query.MarshalJSON(q.Expr)
+}
+
+// UnmarshalJSON decodes the Query from the JSON representation produced by MarshalJSON.
+func (q *Query) UnmarshalJSON(data []byte) error {
+	expr, err := query.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	q.Expr = expr
+
+	return nil
+}
+
+// MarshalBinary encodes the Query into the compact binary representation of its AST, see query.MarshalBinary.
+func (q *Query) MarshalBinary() ([]byte, error) {
+	return query.MarshalBinary(q.Expr)
+}
+
+// UnmarshalBinary decodes the Query from the binary representation produced by MarshalBinary.
+func (q *Query) UnmarshalBinary(data []byte) error {
+	expr, err := query.UnmarshalBinary(data)
+	if err != nil {
+		return err
+	}
+
+	q.Expr = expr
+
+	return nil
+}
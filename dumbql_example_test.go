@@ -1,6 +1,7 @@
 package dumbql_test
 
 import (
+	"encoding/json"
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
@@ -81,3 +82,38 @@ func ExampleParse_booleanFields() {
 	//nolint:lll
 	// Output: (and (and (and (= verified true) (= premium true)) (not (= banned true))) (or (= admin true) (= moderator true)))
 }
+
+func ExampleQuery_MarshalJSON() {
+	q, err := dumbql.Parse(`status:pending and not verified`)
+	if err != nil {
+		panic(err)
+	}
+
+	data, err := json.Marshal(q)
+	if err != nil {
+		panic(err)
+	}
+
+	var decoded dumbql.Query
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(string(data))
+	fmt.Println(decoded.Expr)
+	// Output:
+	// {"version":1,"expr":{"kind":"binary","op":"and","left":{"kind":"field","field":"status","op":"=","value":{"kind":"string","value":"pending"}},"right":{"kind":"not","expr":{"kind":"field","field":"verified","op":"=","value":{"kind":"bool","value":true}}}}}
+	// (and (= status "pending") (not (= verified true)))
+}
+
+func ExampleQuery_Lint() {
+	q, err := dumbql.Parse(`status:pending and (age > 30 and age < 20)`)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, w := range q.Lint() {
+		fmt.Println(w)
+	}
+	// Output: (and (= status "pending") (and (> age 30) (< age 20))) never matches: [(> age 30) (< age 20)] exclude each other
+}
@@ -0,0 +1,288 @@
+// Package es renders DumbQL expressions into the Elasticsearch and OpenSearch query DSL.
+package es
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"go.tomakado.io/dumbql/internal/render"
+	"go.tomakado.io/dumbql/query"
+)
+
+// Renderer renders expressions into the query DSL shared by Elasticsearch and OpenSearch. The result is
+// a plain map, which encoding/json marshals into the value of the "query" key of a search request:
+//
+//	q, err := es.Renderer{Nested: []string{"comments"}}.Query(expr)
+//	body, err := json.Marshal(map[string]any{"query": q})
+//
+// Fields are rendered as dotted paths, so quoted segments like labels.`app/name` become labels.app/name.
+// Values are compared with term-level queries, so text fields should be mapped to their keyword
+// subfields, e.g. with query.FieldMapping.
+type Renderer struct {
+	// Nested lists the paths of fields mapped with the nested type, e.g. "comments". Field expressions
+	// below them are wrapped into a nested query per path, the deepest one innermost. Since each field
+	// expression is wrapped on its own, conditions joined with `and` may be met by different objects.
+	Nested []string
+
+	Like query.LikeMode // Pattern of the wildcard query rendered for `~`, e.g. "jo*" with LikePrefix
+}
+
+// Query renders the expression into a query clause:
+//   - `and` and `or` chains become bool queries with must and should clauses, `not` becomes must_not;
+//   - `:` and `!=` become term queries, or terms queries with one-of values;
+//   - `>`, `>=`, `<` and `<=` become range queries;
+//   - `?` becomes an exists query;
+//   - `~` becomes a wildcard query matching values containing the string, with its wildcards escaped;
+//   - `@>` becomes a bool query with a term query for every value.
+func (r Renderer) Query(expr query.Expr) (map[string]any, error) {
+	nested := make([]string, 0, len(r.Nested))
+
+	for _, path := range r.Nested {
+		p, err := fieldPath(query.Identifier(path))
+		if err != nil {
+			return nil, fmt.Errorf("nested: %w", err)
+		}
+
+		nested = append(nested, p)
+	}
+
+	// The longest path is the innermost one.
+	slices.SortFunc(nested, func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+
+	return (&renderer{Renderer: r, nested: nested}).expr(expr)
+}
+
+type renderer struct {
+	Renderer
+
+	nested []string // Paths of nested fields, the longest first
+}
+
+func (r *renderer) expr(expr query.Expr) (map[string]any, error) {
+	if render.IsNil(expr) {
+		return nil, errors.New("nil expression")
+	}
+
+	switch e := expr.(type) {
+	case *query.BinaryExpr:
+		return r.binary(e)
+	case *query.NotExpr:
+		clause, err := r.expr(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+		return boolQuery("must_not", clause), nil
+	case *query.FieldExpr:
+		return r.field(e)
+	default:
+		return nil, fmt.Errorf("unsupported expression %T", expr)
+	}
+}
+
+// binary renders `and` and `or` chains as a single bool query.
+func (r *renderer) binary(b *query.BinaryExpr) (map[string]any, error) {
+	var occur string
+
+	switch b.Op {
+	case query.And:
+		occur = "must"
+	case query.Or:
+		occur = "should"
+	default:
+		return nil, fmt.Errorf("unknown operator %q", b.Op)
+	}
+
+	operands := render.Flatten(b, b.Op)
+	clauses := make([]any, 0, len(operands))
+
+	for _, operand := range operands {
+		clause, err := r.expr(operand)
+		if err != nil {
+			return nil, err
+		}
+
+		clauses = append(clauses, clause)
+	}
+
+	return boolQuery(occur, clauses...), nil
+}
+
+// boolQuery renders a bool query with the clauses in the given occurrence. Should clauses require
+// at least one of them to match.
+func boolQuery(occur string, clauses ...any) map[string]any {
+	q := map[string]any{occur: clauses}
+	if occur == "should" {
+		q["minimum_should_match"] = 1
+	}
+
+	return map[string]any{"bool": q}
+}
+
+func (r *renderer) field(f *query.FieldExpr) (map[string]any, error) {
+	field, err := fieldPath(f.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	clause, err := r.condition(field, f)
+	if err != nil {
+		return nil, fmt.Errorf("field %q: %w", f.Field, err)
+	}
+
+	for _, path := range r.nested {
+		if strings.HasPrefix(field, path+".") {
+			clause = map[string]any{"nested": map[string]any{"path": path, "query": clause}}
+		}
+	}
+
+	return clause, nil
+}
+
+var rangeOperators = map[query.FieldOperator]string{
+	query.GreaterThan:        "gt",
+	query.GreaterThanOrEqual: "gte",
+	query.LessThan:           "lt",
+	query.LessThanOrEqual:    "lte",
+}
+
+// condition renders the query clause comparing the field.
+func (r *renderer) condition(field string, f *query.FieldExpr) (map[string]any, error) {
+	if f.Op == query.Exists {
+		return map[string]any{"exists": map[string]any{"field": field}}, nil
+	}
+
+	values, isOneOf, err := valuesOf(f.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch f.Op {
+	case query.Equal:
+		return term(field, values, isOneOf), nil
+	case query.NotEqual:
+		return boolQuery("must_not", term(field, values, isOneOf)), nil
+	case query.GreaterThan, query.GreaterThanOrEqual, query.LessThan, query.LessThanOrEqual:
+		if isOneOf {
+			return nil, fmt.Errorf("operator %q doesn't support one-of values", f.Op)
+		}
+
+		return map[string]any{"range": map[string]any{field: map[string]any{rangeOperators[f.Op]: values[0]}}}, nil
+	case query.Like:
+		clauses := make([]any, 0, len(values))
+		for _, v := range values {
+			clauses = append(clauses, r.wildcard(field, v))
+		}
+
+		return anyOf(clauses), nil
+	case query.Contains:
+		clauses := make([]any, 0, len(values))
+		for _, v := range values {
+			clauses = append(clauses, term(field, []any{v}, false))
+		}
+
+		if len(clauses) == 0 {
+			return map[string]any{"match_all": map[string]any{}}, nil
+		}
+
+		return boolQuery("must", clauses...), nil
+	default:
+		return nil, fmt.Errorf("unknown operator %q", f.Op)
+	}
+}
+
+// valuesOf returns the values of the literal or of the one-of list. Numbers JSON can't represent
+// are reported as errors.
+func valuesOf(v query.Valuer) ([]any, bool, error) {
+	values, isOneOf, err := render.Values(v)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, val := range values {
+		if n, ok := val.(float64); ok && (math.IsNaN(n) || math.IsInf(n, 0)) {
+			return nil, false, fmt.Errorf("number %v can't be represented in JSON", n)
+		}
+	}
+
+	return values, isOneOf, nil
+}
+
+// term renders a term query, or a terms query for one-of values.
+func term(field string, values []any, isOneOf bool) map[string]any {
+	if isOneOf {
+		return map[string]any{"terms": map[string]any{field: values}}
+	}
+
+	return map[string]any{"term": map[string]any{field: values[0]}}
+}
+
+// anyOf renders clauses of which at least one has to match. A single clause is rendered as is and
+// no clauses never match.
+func anyOf(clauses []any) map[string]any {
+	switch len(clauses) {
+	case 0:
+		return map[string]any{"match_none": map[string]any{}}
+	case 1:
+		return clauses[0].(map[string]any)
+	default:
+		return boolQuery("should", clauses...)
+	}
+}
+
+// wildcard renders a wildcard query matching the string the way `~` does in the configured Like mode.
+func (r *renderer) wildcard(field string, v any) map[string]any {
+	escaped := escapeWildcard(fmt.Sprint(v))
+
+	var pattern string
+
+	switch r.Like {
+	case query.LikePrefix:
+		pattern = escaped + "*"
+	case query.LikeSuffix:
+		pattern = "*" + escaped
+	default:
+		pattern = "*" + escaped + "*"
+	}
+
+	return map[string]any{"wildcard": map[string]any{field: map[string]any{"value": pattern}}}
+}
+
+var wildcardEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`)
+
+// escapeWildcard escapes the wildcards of wildcard queries in s, so it is matched literally.
+func escapeWildcard(s string) string {
+	return wildcardEscaper.Replace(s)
+}
+
+// fieldPath renders the identifier as a dotted field path. Index segments have no counterpart
+// in the query DSL, since arrays are matched by any of their elements, and are rejected.
+func fieldPath(id query.Identifier) (string, error) {
+	var b strings.Builder
+
+	for seg := range id.Segments() {
+		switch seg.Kind {
+		case query.FieldSegment, query.KeySegment:
+		case query.IndexSegment:
+			return "", fmt.Errorf("field %q: index segments are not supported", string(id))
+		default:
+			return "", fmt.Errorf("invalid field %q", string(id))
+		}
+
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+
+		b.WriteString(seg.Name)
+	}
+
+	if b.Len() == 0 {
+		return "", fmt.Errorf("invalid field %q", string(id))
+	}
+
+	return b.String(), nil
+}
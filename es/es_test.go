@@ -0,0 +1,86 @@
+package es_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/es"
+	"go.tomakado.io/dumbql/internal/rendertest"
+	"go.tomakado.io/dumbql/query"
+)
+
+func TestRenderer_Query(t *testing.T) { //nolint:funlen
+	renderer := es.Renderer{Nested: []string{"comments", "comments.replies"}}
+
+	tests := []struct {
+		golden   string
+		input    string
+		renderer *es.Renderer
+	}{
+		{golden: "term", input: `status:open`},
+		{golden: "terms", input: `status:[open, closed] and id!=[1, 2]`},
+		{golden: "not_equal", input: `status!=open`},
+		{golden: "range", input: `age>=18 and age<65.5 and created<="2024-03-01"`},
+		{golden: "exists", input: `email? and not phone?`},
+		{golden: "wildcard", input: `name~"jo*h?n\\"`},
+		{golden: "wildcard_one_of", input: `name~[jo, an] or name~[]`},
+		{golden: "wildcard_prefix", input: `name~jo`, renderer: &es.Renderer{Like: query.LikePrefix}},
+		{golden: "bool", input: `(a:1 or b:true or c:x) and not (d:1 and e:2)`},
+		{golden: "contains", input: `tags @> [a, b] and labels @> []`},
+		{golden: "nested", input: `comments.author:john and comments.replies.likes>10 and comments?`},
+		{golden: "paths", input: "labels.`app/name`:web and attributes[\"color\"]:red"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.golden, func(t *testing.T) {
+			r := renderer
+			if test.renderer != nil {
+				r = *test.renderer
+			}
+
+			q, err := r.Query(rendertest.MustParse(t, test.input))
+			require.NoError(t, err)
+
+			rendertest.Golden(t, test.golden, q)
+		})
+	}
+}
+
+func TestRenderer_Query_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     query.Expr
+		renderer es.Renderer
+	}{
+		{name: "nil", expr: nil},
+		{name: "typed nil", expr: (*query.FieldExpr)(nil)},
+		{name: "nil operand", expr: &query.NotExpr{}},
+		{name: "index segment", expr: rendertest.MustParse(t, `items[0].sku:x`)},
+		{name: "range of one-of", expr: rendertest.MustParse(t, `age>[1, 2]`)},
+		{name: "nil value", expr: &query.FieldExpr{Field: "a", Op: query.Equal}},
+		{name: "unknown operator", expr: &query.FieldExpr{Field: "a", Op: 42, Value: &query.StringLiteral{}}},
+		{
+			name: "unknown boolean operator",
+			expr: &query.BinaryExpr{Left: rendertest.MustParse(t, `a:1`), Op: 42, Right: rendertest.MustParse(t, `b:1`)},
+		},
+		{
+			name: "NaN",
+			expr: &query.FieldExpr{Field: "a", Op: query.Equal, Value: &query.NumberLiteral{NumberValue: math.NaN()}},
+		},
+		{
+			name: "nested one-of",
+			expr: &query.FieldExpr{Field: "a", Op: query.Equal, Value: &query.OneOfExpr{
+				Values: []query.Valuer{&query.OneOfExpr{}},
+			}},
+		},
+		{name: "invalid nested path", expr: rendertest.MustParse(t, `a:1`), renderer: es.Renderer{Nested: []string{"a."}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.renderer.Query(test.expr)
+			require.Error(t, err)
+		})
+	}
+}
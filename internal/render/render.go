@@ -0,0 +1,60 @@
+// Package render holds the tree handling shared by the renderers of the es and mongo packages.
+package render
+
+import (
+	"errors"
+	"reflect"
+
+	"go.tomakado.io/dumbql/query"
+)
+
+// IsNil reports whether v is nil or a nil pointer.
+func IsNil(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+// Flatten returns the operands of the chain of op, so `a and b and c` is rendered as a single clause.
+func Flatten(expr query.Expr, op query.BooleanOperator) []query.Expr {
+	return flatten(nil, expr, op)
+}
+
+func flatten(operands []query.Expr, expr query.Expr, op query.BooleanOperator) []query.Expr {
+	if b, ok := expr.(*query.BinaryExpr); ok && !IsNil(b) && b.Op == op {
+		return flatten(flatten(operands, b.Left, op), b.Right, op)
+	}
+
+	return append(operands, expr)
+}
+
+// Values returns the values of the literal or of the one-of list and reports whether it is a one-of list.
+// Nil and nested one-of values are reported as errors.
+func Values(v query.Valuer) ([]any, bool, error) {
+	items := []query.Valuer{v}
+
+	oneOf, isOneOf := v.(*query.OneOfExpr)
+	if isOneOf && !IsNil(oneOf) {
+		items = oneOf.Values
+	}
+
+	values := make([]any, 0, len(items))
+
+	for _, item := range items {
+		if IsNil(item) {
+			return nil, false, errors.New("nil value")
+		}
+
+		if _, nested := item.(*query.OneOfExpr); nested {
+			return nil, false, errors.New("nested one-of values are not supported")
+		}
+
+		values = append(values, item.Value())
+	}
+
+	return values, isOneOf, nil
+}
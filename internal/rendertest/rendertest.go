@@ -0,0 +1,44 @@
+// Package rendertest holds the golden file harness shared by the tests of the es and mongo packages.
+package rendertest
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// Golden compares the JSON encoding of got with testdata/<name>.json. With -update the file is
+// rewritten instead.
+func Golden(t *testing.T, name string, got any) {
+	t.Helper()
+
+	data, err := json.MarshalIndent(got, "", "  ")
+	require.NoError(t, err)
+
+	path := filepath.Join("testdata", name+".json")
+	if *update {
+		require.NoError(t, os.WriteFile(path, append(data, '\n'), 0o600))
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), string(data))
+}
+
+// MustParse parses the query and fails the test on errors.
+func MustParse(t testing.TB, q string) query.Expr {
+	t.Helper()
+
+	ast, err := query.Parse("test", []byte(q))
+	require.NoError(t, err)
+
+	return ast.(query.Expr)
+}
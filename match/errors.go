@@ -3,6 +3,9 @@ package match
 import "errors"
 
 var (
-	ErrFieldNotFound = errors.New("field not found")
-	ErrNotAStruct    = errors.New("not a struct")
+	ErrFieldNotFound   = errors.New("field not found")
+	ErrNotAStruct      = errors.New("not a struct")
+	ErrNotIndexable    = errors.New("not a slice or array")
+	ErrIndexOutOfRange = errors.New("index out of range")
+	ErrKeyNotFound     = errors.New("map key not found")
 )
@@ -1,18 +1,33 @@
 package match
 
-import "iter"
+import (
+	"iter"
+	"strconv"
 
+	"go.tomakado.io/dumbql/query"
+)
+
+// Path iterates over the names of the segments of the field path s, e.g. items[0].sku yields
+// "items", "0" and "sku". Index segments yield their index, malformed segments yield an empty name.
+// Use Segments to tell field, index and key segments apart.
 func Path(s string) iter.Seq[string] {
 	return func(yield func(string) bool) {
-		start := 0
-		for i := range len(s) {
-			if s[i] == '.' {
-				if !yield(s[start:i]) {
-					return
-				}
-				start = i + 1
+		for seg := range Segments(s) {
+			name := seg.Name
+			if seg.Kind == query.IndexSegment {
+				name = strconv.Itoa(seg.Index)
+			}
+
+			if !yield(name) {
+				return
 			}
 		}
-		yield(s[start:])
 	}
 }
+
+// Segments iterates over the typed segments of the field path s, e.g. items[0].sku yields
+// the field segment "items", the index segment 0 and the field segment "sku".
+// See query.Identifier.Segments for the details.
+func Segments(s string) iter.Seq[query.Segment] {
+	return query.Identifier(s).Segments()
+}
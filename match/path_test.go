@@ -0,0 +1,25 @@
+package match_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.tomakado.io/dumbql/match"
+	"go.tomakado.io/dumbql/query"
+)
+
+func TestPath(t *testing.T) {
+	assert.Equal(t, []string{"address", "city"}, slices.Collect(match.Path("address.city")))
+	assert.Equal(t, []string{"items", "0", "sku"}, slices.Collect(match.Path("items[0].sku")))
+	assert.Equal(t, []string{"labels", "app/name"}, slices.Collect(match.Path("labels.`app/name`")))
+	assert.Equal(t, []string{"a", ""}, slices.Collect(match.Path("a..b")))
+}
+
+func TestSegments(t *testing.T) {
+	assert.Equal(t, []query.Segment{
+		{Kind: query.FieldSegment, Name: "items"},
+		{Kind: query.IndexSegment, Index: -1},
+		{Kind: query.KeySegment, Name: "color"},
+	}, slices.Collect(match.Segments(`items[-1]["color"]`)))
+}
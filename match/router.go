@@ -2,6 +2,8 @@ package match
 
 import (
 	"reflect"
+
+	"go.tomakado.io/dumbql/query"
 )
 
 // For high-performance applications, consider using the code generator
@@ -13,7 +15,8 @@ import (
 // //go:generate dumbqlgen -type User -package .
 
 // ReflectRouter implements Router for struct targets.
-// It supports struct tags using the `dumbql` tag name and nested field access using dot notation.
+// It supports struct tags using the `dumbql` tag name, nested field access using dot notation
+// and indexed access to slices, arrays and maps (e.g. items[0].sku, items[-1], attributes["color"]).
 type ReflectRouter struct{}
 
 // Route resolves a field path in the target struct and returns the value.
@@ -24,12 +27,8 @@ func (r *ReflectRouter) Route(target any, field string) (any, error) {
 		err    error
 	)
 
-	for field := range Path(field) {
-		if field == "" {
-			return nil, ErrFieldNotFound
-		}
-
-		cursor, err = r.resolveField(cursor, field)
+	for seg := range Segments(field) {
+		cursor, err = ResolveSegment(cursor, seg)
 		if err != nil {
 			return nil, err
 		}
@@ -38,7 +37,12 @@ func (r *ReflectRouter) Route(target any, field string) (any, error) {
 	return cursor, nil
 }
 
-func (r *ReflectRouter) resolveField(target any, field string) (any, error) {
+// ResolveSegment resolves a single path segment in the target using reflection.
+//
+// Field and key segments address struct fields (by `dumbql` tag or field name) and entries of maps
+// with string keys. Index segments address slice and array elements, negative index counts from the end.
+// Missing map keys and out of range indexes are reported with ErrKeyNotFound and ErrIndexOutOfRange.
+func ResolveSegment(target any, seg query.Segment) (any, error) {
 	v := reflect.ValueOf(target)
 	if v.Kind() == reflect.Ptr {
 		if v.IsNil() {
@@ -47,10 +51,24 @@ func (r *ReflectRouter) resolveField(target any, field string) (any, error) {
 		v = v.Elem()
 	}
 
-	if v.Kind() != reflect.Struct {
-		return nil, ErrNotAStruct // Not a struct, can't resolve field
+	switch seg.Kind {
+	case query.FieldSegment, query.KeySegment:
+		switch v.Kind() { //nolint:exhaustive
+		case reflect.Struct:
+			return resolveField(v, seg.Name)
+		case reflect.Map:
+			return resolveKey(v, seg.Name)
+		default:
+			return nil, ErrNotAStruct // Not a struct, can't resolve field
+		}
+	case query.IndexSegment:
+		return resolveIndex(v, seg.Index)
+	default:
+		return nil, ErrFieldNotFound
 	}
+}
 
+func resolveField(v reflect.Value, field string) (any, error) {
 	t := v.Type()
 	for i := range t.NumField() {
 		f := t.Field(i)
@@ -74,3 +92,33 @@ func (r *ReflectRouter) resolveField(target any, field string) (any, error) {
 	// Field not found
 	return nil, ErrFieldNotFound
 }
+
+func resolveKey(v reflect.Value, key string) (any, error) {
+	keyType := v.Type().Key()
+	if keyType.Kind() != reflect.String {
+		return nil, ErrKeyNotFound // Only string keys can be addressed by name
+	}
+
+	value := v.MapIndex(reflect.ValueOf(key).Convert(keyType))
+	if !value.IsValid() {
+		return nil, ErrKeyNotFound
+	}
+
+	return value.Interface(), nil
+}
+
+func resolveIndex(v reflect.Value, idx int) (any, error) {
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, ErrNotIndexable
+	}
+
+	if idx < 0 {
+		idx += v.Len()
+	}
+
+	if idx < 0 || idx >= v.Len() {
+		return nil, ErrIndexOutOfRange
+	}
+
+	return v.Index(idx).Interface(), nil
+}
@@ -19,6 +19,10 @@ type Router interface {
 // It supports struct tags using the `dumbql` tag name, which allows you to specify a custom field name.
 type StructMatcher struct {
 	router Router
+
+	// Like defines the part of string values matched by `~`, contains by default. Use the same mode
+	// in query.SQLRenderer to get the same results from SQL.
+	Like query.LikeMode
 }
 
 func NewStructMatcher(router Router) *StructMatcher {
@@ -47,7 +51,8 @@ func (m *StructMatcher) MatchNot(target any, expr query.Expr) bool {
 
 // MatchField matches a field in the target struct using the provided value and operator.
 // It supports struct tags using the `dumbql` tag name and nested field access using dot notation.
-// For example: "address.city" to access the city field in the address struct.
+// For example: "address.city" to access the city field in the address struct, or "items[0].sku"
+// to access the sku field of the first element of the items slice.
 func (m *StructMatcher) MatchField(target any, field string, value query.Valuer, op query.FieldOperator) bool {
 	m.lazyInit()
 
@@ -63,10 +68,98 @@ func (m *StructMatcher) MatchField(target any, field string, value query.Valuer,
 	}
 }
 
+// MatchValue matches the target value using the provided value and operator. Slices and arrays match
+// the way query.SQLRenderer renders array columns:
+//   - `tags:a`, `tags>a` and `tags~a` match if any element matches;
+//   - `tags:[a, b]` matches if any element is one of the values;
+//   - `tags!=a` and `tags!=[a, b]` match if no element is equal to any of the values;
+//   - `tags @> [a, b]` matches if every value is equal to an element.
+//
+// Byte slices and arrays, like []byte and json.RawMessage, hold a single value and are not matched by element.
 func (m *StructMatcher) MatchValue(target any, value query.Valuer, op query.FieldOperator) bool {
+	if elems, ok := sliceElements(target); ok {
+		return m.matchSlice(elems, value, op)
+	}
+
+	if op == query.Like && m.Like != query.LikeContains {
+		return matchLike(target, value, m.Like)
+	}
+
 	return value.Match(target, op)
 }
 
+func (m *StructMatcher) matchSlice(elems []any, value query.Valuer, op query.FieldOperator) bool {
+	switch op { //nolint:exhaustive
+	case query.Contains:
+		values := []query.Valuer{value}
+		if oneOf, ok := value.(*query.OneOfExpr); ok {
+			values = oneOf.Values
+		}
+
+		for _, v := range values {
+			if !m.matchAny(elems, v, query.Equal) {
+				return false
+			}
+		}
+
+		return true
+	case query.NotEqual:
+		return !m.matchAny(elems, value, query.Equal)
+	default:
+		return m.matchAny(elems, value, op)
+	}
+}
+
+func (m *StructMatcher) matchAny(elems []any, value query.Valuer, op query.FieldOperator) bool {
+	for _, elem := range elems {
+		if m.MatchValue(elem, value, op) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sliceElements returns the elements of slice and array targets, except for byte slices and arrays.
+func sliceElements(target any) ([]any, bool) {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array || v.Type().Elem().Kind() == reflect.Uint8 {
+		return nil, false
+	}
+
+	elems := make([]any, 0, v.Len())
+	for i := range v.Len() {
+		elems = append(elems, v.Index(i).Interface())
+	}
+
+	return elems, true
+}
+
+// matchLike matches string targets against the string values of `~` in the given mode.
+func matchLike(target any, value query.Valuer, mode query.LikeMode) bool {
+	str, ok := target.(string)
+	if !ok {
+		return false
+	}
+
+	switch v := value.(type) {
+	case *query.StringLiteral:
+		return mode.Match(str, v.StringValue)
+	case query.Identifier:
+		return mode.Match(str, string(v))
+	case *query.OneOfExpr:
+		for _, item := range v.Values {
+			if matchLike(target, item, mode) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
+
 func isZero(tv any) bool {
 	if tv == nil {
 		return true
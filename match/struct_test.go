@@ -1,9 +1,11 @@
 package match_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.tomakado.io/dumbql/match"
 	"go.tomakado.io/dumbql/query"
 )
@@ -429,6 +431,243 @@ func TestStructMatcher_MatchField(t *testing.T) { //nolint:funlen
 	}
 }
 
+func TestStructMatcher_MatchField_QuotedSegments(t *testing.T) {
+	type labels struct {
+		Name      string `dumbql:"app.kubernetes.io/name"`
+		RequestID string `dumbql:"x-request-id"`
+	}
+
+	type resource struct {
+		Labels labels `dumbql:"labels"`
+	}
+
+	matcher := &match.StructMatcher{}
+	target := &resource{
+		Labels: labels{
+			Name:      "web",
+			RequestID: "abc",
+		},
+	}
+
+	tests := []struct {
+		name  string
+		field string
+		value query.Valuer
+		want  bool
+	}{
+		{
+			name:  "quoted segment with dots",
+			field: "labels.`app.kubernetes.io/name`",
+			value: &query.StringLiteral{StringValue: "web"},
+			want:  true,
+		},
+		{
+			name:  "quoted segment with dash",
+			field: "labels.`x-request-id`",
+			value: &query.StringLiteral{StringValue: "abc"},
+			want:  true,
+		},
+		{
+			name:  "quoted segment value mismatch",
+			field: "labels.`x-request-id`",
+			value: &query.StringLiteral{StringValue: "xyz"},
+			want:  false,
+		},
+		{
+			name:  "unquoted dotted path is not a single key",
+			field: "labels.app.kubernetes.io/name",
+			value: &query.StringLiteral{StringValue: "web"},
+			want:  true, // Should match when field not found
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := matcher.MatchField(target, test.field, test.value, query.Equal)
+			assert.Equal(t, test.want, result)
+		})
+	}
+}
+
+func TestStructMatcher_MatchField_IndexedSegments(t *testing.T) { //nolint:funlen
+	type item struct {
+		SKU    string `dumbql:"sku"`
+		Status string `dumbql:"status"`
+	}
+
+	type order struct {
+		Items      []item            `dumbql:"items"`
+		Pair       [2]int64          `dumbql:"pair"`
+		Attributes map[string]string `dumbql:"attributes"`
+		Extra      map[string]any    `dumbql:"extra"`
+		Counts     map[int]int       `dumbql:"counts"`
+		Name       string            `dumbql:"name"`
+	}
+
+	matcher := &match.StructMatcher{}
+	target := &order{
+		Items: []item{
+			{SKU: "A-1", Status: "pending"},
+			{SKU: "B-2", Status: "shipped"},
+		},
+		Pair:       [2]int64{7, 9},
+		Attributes: map[string]string{"color": "red", "app.kubernetes.io/name": "web"},
+		Extra:      map[string]any{"dims": map[string]any{"width": 10}, "tags": []any{"x", "y"}},
+		Counts:     map[int]int{1: 1},
+		Name:       "order",
+	}
+
+	tests := []struct {
+		name  string
+		field string
+		value query.Valuer
+		op    query.FieldOperator
+		want  bool
+	}{
+		{
+			name:  "slice index",
+			field: "items[0].sku",
+			value: &query.StringLiteral{StringValue: "A-1"},
+			op:    query.Equal,
+			want:  true,
+		},
+		{
+			name:  "negative slice index",
+			field: "items[-1].status",
+			value: &query.StringLiteral{StringValue: "shipped"},
+			op:    query.Equal,
+			want:  true,
+		},
+		{
+			name:  "index out of range",
+			field: "items[5].sku",
+			value: &query.StringLiteral{StringValue: "A-1"},
+			op:    query.Equal,
+			want:  false,
+		},
+		{
+			name:  "negative index out of range",
+			field: "items[-3].sku",
+			value: &query.StringLiteral{StringValue: "A-1"},
+			op:    query.Equal,
+			want:  false,
+		},
+		{
+			name:  "array index",
+			field: "pair[1]",
+			value: &query.NumberLiteral{NumberValue: 9},
+			op:    query.Equal,
+			want:  true,
+		},
+		{
+			name:  "map key",
+			field: `attributes["color"]`,
+			value: &query.StringLiteral{StringValue: "red"},
+			op:    query.Equal,
+			want:  true,
+		},
+		{
+			name:  "map key with dots",
+			field: `attributes["app.kubernetes.io/name"]`,
+			value: &query.StringLiteral{StringValue: "web"},
+			op:    query.Equal,
+			want:  true,
+		},
+		{
+			name:  "map key as field segment",
+			field: "attributes.color",
+			value: &query.StringLiteral{StringValue: "red"},
+			op:    query.Equal,
+			want:  true,
+		},
+		{
+			name:  "missing map key",
+			field: `attributes["size"]`,
+			value: &query.StringLiteral{StringValue: "XL"},
+			op:    query.Equal,
+			want:  false,
+		},
+		{
+			name:  "missing map key presence",
+			field: `attributes["size"]`,
+			value: &query.BoolLiteral{BoolValue: true},
+			op:    query.Exists,
+			want:  false,
+		},
+		{
+			name:  "nested maps of any",
+			field: `extra["dims"].width`,
+			value: &query.NumberLiteral{NumberValue: 10},
+			op:    query.GreaterThanOrEqual,
+			want:  true,
+		},
+		{
+			name:  "slice inside map of any",
+			field: `extra["tags"][-1]`,
+			value: &query.StringLiteral{StringValue: "y"},
+			op:    query.Equal,
+			want:  true,
+		},
+		{
+			name:  "key segment on struct",
+			field: `items[1]["sku"]`,
+			value: &query.StringLiteral{StringValue: "B-2"},
+			op:    query.Equal,
+			want:  true,
+		},
+		{
+			name:  "key on map with non-string keys",
+			field: `counts["1"]`,
+			value: &query.NumberLiteral{NumberValue: 1},
+			op:    query.Equal,
+			want:  false,
+		},
+		{
+			name:  "index on non-indexable value",
+			field: "name[0]",
+			value: &query.StringLiteral{StringValue: "o"},
+			op:    query.Equal,
+			want:  false,
+		},
+		{
+			name:  "index on struct",
+			field: "items[0][0]",
+			value: &query.StringLiteral{StringValue: "A-1"},
+			op:    query.Equal,
+			want:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := matcher.MatchField(target, test.field, test.value, test.op)
+			assert.Equal(t, test.want, result)
+		})
+	}
+}
+
+func TestResolveSegment_Errors(t *testing.T) {
+	var nilPtr *address
+
+	_, err := match.ResolveSegment(nilPtr, query.Segment{Kind: query.FieldSegment, Name: "city"})
+	require.ErrorIs(t, err, match.ErrNotAStruct)
+
+	_, err = match.ResolveSegment("text", query.Segment{Kind: query.KeySegment, Name: "city"})
+	require.ErrorIs(t, err, match.ErrNotAStruct)
+
+	_, err = match.ResolveSegment([]int{1}, query.Segment{Kind: query.IndexSegment, Index: 1})
+	require.ErrorIs(t, err, match.ErrIndexOutOfRange)
+
+	_, err = match.ResolveSegment(map[string]int{}, query.Segment{Kind: query.IndexSegment})
+	require.ErrorIs(t, err, match.ErrNotIndexable)
+
+	_, err = match.ResolveSegment(map[string]int{}, query.Segment{Kind: query.KeySegment, Name: "x"})
+	require.ErrorIs(t, err, match.ErrKeyNotFound)
+
+	_, err = match.ResolveSegment(address{}, query.Segment{})
+	require.ErrorIs(t, err, match.ErrFieldNotFound)
+}
+
 func TestStructMatcher_MatchValue(t *testing.T) {
 	t.Run("string", testMatchValueString)
 	t.Run("integer", testMatchValueInteger)
@@ -812,3 +1051,99 @@ func testMatchValueTypeMismatch(t *testing.T) {
 		})
 	}
 }
+
+func TestStructMatcher_MatchValue_LikeMode(t *testing.T) {
+	hello := &query.StringLiteral{StringValue: "hello"}
+	world := &query.StringLiteral{StringValue: "world"}
+	oneOf := &query.OneOfExpr{Values: []query.Valuer{query.Identifier("foo"), world}}
+
+	tests := []struct {
+		mode   query.LikeMode
+		target any
+		value  query.Valuer
+		want   bool
+	}{
+		{mode: query.LikeContains, target: "say hello world", value: hello, want: true},
+		{mode: query.LikePrefix, target: "hello world", value: hello, want: true},
+		{mode: query.LikePrefix, target: "say hello", value: hello, want: false},
+		{mode: query.LikeSuffix, target: "say hello", value: hello, want: true},
+		{mode: query.LikeSuffix, target: "hello world", value: hello, want: false},
+		{mode: query.LikePrefix, target: "foobar", value: oneOf, want: true},
+		{mode: query.LikeSuffix, target: "hello world", value: oneOf, want: true},
+		{mode: query.LikeSuffix, target: "foobar", value: oneOf, want: false},
+		{mode: query.LikePrefix, target: 42, value: hello, want: false},
+		{mode: query.LikePrefix, target: "42", value: &query.NumberLiteral{NumberValue: 42}, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mode.String(), func(t *testing.T) {
+			matcher := &match.StructMatcher{Like: test.mode}
+			assert.Equal(t, test.want, matcher.MatchValue(test.target, test.value, query.Like))
+		})
+	}
+}
+
+func TestStructMatcher_MatchValue_Slices(t *testing.T) { //nolint:funlen
+	str := func(s string) query.Valuer { return &query.StringLiteral{StringValue: s} }
+	oneOf := func(values ...query.Valuer) query.Valuer { return &query.OneOfExpr{Values: values} }
+
+	tags := []string{"urgent", "bug"}
+
+	tests := []struct {
+		name   string
+		target any
+		value  query.Valuer
+		op     query.FieldOperator
+		want   bool
+	}{
+		{name: "any element equals", target: tags, value: str("bug"), op: query.Equal, want: true},
+		{name: "no element equals", target: tags, value: str("docs"), op: query.Equal, want: false},
+		{name: "not equal", target: tags, value: str("docs"), op: query.NotEqual, want: true},
+		{name: "not equal to an element", target: tags, value: str("bug"), op: query.NotEqual, want: false},
+		{name: "overlap", target: tags, value: oneOf(str("docs"), str("bug")), op: query.Equal, want: true},
+		{name: "no overlap", target: tags, value: oneOf(str("docs")), op: query.Equal, want: false},
+		{name: "not equal to one-of", target: tags, value: oneOf(str("docs"), str("bug")), op: query.NotEqual},
+		{name: "contains", target: tags, value: oneOf(str("bug"), str("urgent")), op: query.Contains, want: true},
+		{name: "contains some", target: tags, value: oneOf(str("bug"), str("docs")), op: query.Contains},
+		{name: "contains scalar", target: tags, value: str("bug"), op: query.Contains, want: true},
+		{name: "contains empty", target: tags, value: oneOf(), op: query.Contains, want: true},
+		{name: "like", target: tags, value: str("urg"), op: query.Like, want: true},
+		{
+			name:   "comparison",
+			target: [3]int{1, 5, 10},
+			value:  &query.NumberLiteral{NumberValue: 8},
+			op:     query.GreaterThan,
+			want:   true,
+		},
+		{name: "empty slice", target: []string{}, value: str("bug"), op: query.Equal, want: false},
+		{name: "scalar contains", target: "bug", value: str("bug"), op: query.Contains, want: false},
+		{name: "bytes", target: []byte("ab"), value: &query.NumberLiteral{NumberValue: 'a'}, op: query.Equal},
+		{name: "raw JSON", target: json.RawMessage(`1`), value: &query.NumberLiteral{NumberValue: '1'}, op: query.Equal},
+		{name: "byte array", target: [2]byte{1, 2}, value: &query.NumberLiteral{NumberValue: 1}, op: query.Equal},
+		{name: "raw JSON contains", target: json.RawMessage(`"x"`), value: str(`"`), op: query.Contains},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matcher := &match.StructMatcher{}
+			assert.Equal(t, test.want, matcher.MatchValue(test.target, test.value, test.op))
+		})
+	}
+
+	t.Run("like mode", func(t *testing.T) {
+		matcher := &match.StructMatcher{Like: query.LikeSuffix}
+		assert.True(t, matcher.MatchValue(tags, str("ug"), query.Like))
+		assert.False(t, matcher.MatchValue(tags, str("urg"), query.Like))
+	})
+
+	t.Run("query", func(t *testing.T) {
+		type issue struct {
+			Tags []string `dumbql:"tags"`
+		}
+
+		expr, err := query.Parse("test", []byte(`tags @> [bug, urgent] and not tags:docs`))
+		require.NoError(t, err)
+		assert.True(t, expr.(query.Expr).Match(&issue{Tags: tags}, &match.StructMatcher{}))
+		assert.False(t, expr.(query.Expr).Match(&issue{Tags: []string{"bug"}}, &match.StructMatcher{}))
+	})
+}
@@ -0,0 +1,203 @@
+// Package mongo renders DumbQL expressions into MongoDB filter documents.
+package mongo
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.tomakado.io/dumbql/internal/render"
+	"go.tomakado.io/dumbql/query"
+)
+
+// Renderer renders expressions into MongoDB filter documents. The result is a plain map, which the driver
+// accepts as a filter and encodes as BSON, so no driver dependency is needed:
+//
+//	filter, err := mongo.Renderer{}.Filter(expr)
+//	cursor, err := collection.Find(ctx, filter)
+//
+// Field paths are rendered as dotted paths, index segments become positions, e.g. `items[0].sku` is
+// "items.0.sku". Arrays are matched by any of their elements, the way StructMatcher matches slices.
+type Renderer struct {
+	Like query.LikeMode // Anchors of the $regex rendered for `~`, e.g. "^jo" with LikePrefix
+}
+
+// Filter renders the expression into a filter document:
+//   - `and` and `or` chains become $and and $or, `not` becomes $nor;
+//   - `:` and `!=` become $eq and $ne, or $in and $nin with one-of values;
+//   - `>`, `>=`, `<` and `<=` become $gt, $gte, $lt and $lte;
+//   - `?` becomes $exists with a $ne check for null, the way SQL checks `IS NOT NULL`;
+//   - `~` becomes $regex matching values containing the string, with its metacharacters escaped;
+//   - `@>` becomes $all.
+func (r Renderer) Filter(expr query.Expr) (map[string]any, error) {
+	if render.IsNil(expr) {
+		return nil, errors.New("nil expression")
+	}
+
+	switch e := expr.(type) {
+	case *query.BinaryExpr:
+		return r.binary(e)
+	case *query.NotExpr:
+		filter, err := r.Filter(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{"$nor": []any{filter}}, nil
+	case *query.FieldExpr:
+		return r.field(e)
+	default:
+		return nil, fmt.Errorf("unsupported expression %T", expr)
+	}
+}
+
+// binary renders `and` and `or` chains as a single $and or $or.
+func (r Renderer) binary(b *query.BinaryExpr) (map[string]any, error) {
+	var op string
+
+	switch b.Op {
+	case query.And:
+		op = "$and"
+	case query.Or:
+		op = "$or"
+	default:
+		return nil, fmt.Errorf("unknown operator %q", b.Op)
+	}
+
+	operands := render.Flatten(b, b.Op)
+	filters := make([]any, 0, len(operands))
+
+	for _, operand := range operands {
+		filter, err := r.Filter(operand)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return map[string]any{op: filters}, nil
+}
+
+var operators = map[query.FieldOperator]string{
+	query.Equal:              "$eq",
+	query.NotEqual:           "$ne",
+	query.GreaterThan:        "$gt",
+	query.GreaterThanOrEqual: "$gte",
+	query.LessThan:           "$lt",
+	query.LessThanOrEqual:    "$lte",
+}
+
+func (r Renderer) field(f *query.FieldExpr) (map[string]any, error) {
+	field, err := fieldPath(f.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	cond, err := r.condition(field, f)
+	if err != nil {
+		return nil, fmt.Errorf("field %q: %w", f.Field, err)
+	}
+
+	return cond, nil
+}
+
+// condition renders the filter comparing the field.
+func (r Renderer) condition(field string, f *query.FieldExpr) (map[string]any, error) {
+	if f.Op == query.Exists {
+		return map[string]any{field: map[string]any{"$exists": true, "$ne": nil}}, nil
+	}
+
+	values, isOneOf, err := render.Values(f.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case f.Op == query.Like:
+		return r.regex(field, values), nil
+	case f.Op == query.Contains:
+		if len(values) == 0 {
+			// Every array contains the empty set, while $all with no values never matches.
+			return map[string]any{}, nil
+		}
+
+		return map[string]any{field: map[string]any{"$all": values}}, nil
+	case isOneOf && f.Op == query.Equal:
+		return map[string]any{field: map[string]any{"$in": values}}, nil
+	case isOneOf && f.Op == query.NotEqual:
+		return map[string]any{field: map[string]any{"$nin": values}}, nil
+	case isOneOf:
+		return nil, fmt.Errorf("operator %q doesn't support one-of values", f.Op)
+	}
+
+	op, ok := operators[f.Op]
+	if !ok {
+		return nil, fmt.Errorf("unknown operator %q", f.Op)
+	}
+
+	return map[string]any{field: map[string]any{op: values[0]}}, nil
+}
+
+// regex renders $regex matching the values the way `~` does in the configured Like mode. With multiple
+// values any of them has to match, no values never match.
+func (r Renderer) regex(field string, values []any) map[string]any {
+	if len(values) == 0 {
+		return map[string]any{field: map[string]any{"$in": []any{}}}
+	}
+
+	filters := make([]any, 0, len(values))
+
+	for _, v := range values {
+		pattern := regexp.QuoteMeta(fmt.Sprint(v))
+
+		switch r.Like {
+		case query.LikePrefix:
+			pattern = "^" + pattern
+		case query.LikeSuffix:
+			pattern += "$"
+		case query.LikeContains:
+		}
+
+		filters = append(filters, map[string]any{field: map[string]any{"$regex": pattern}})
+	}
+
+	if len(filters) == 1 {
+		return filters[0].(map[string]any)
+	}
+
+	return map[string]any{"$or": filters}
+}
+
+// fieldPath renders the identifier as a dotted field path with index segments as positions. MongoDB can't
+// address names containing dots or starting with `$` in filters, so they are rejected, as are negative indexes.
+func fieldPath(id query.Identifier) (string, error) {
+	var b strings.Builder
+
+	for seg := range id.Segments() {
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+
+		switch {
+		case seg.Kind == query.IndexSegment && seg.Index >= 0:
+			b.WriteString(strconv.Itoa(seg.Index))
+		case seg.Kind == query.IndexSegment:
+			return "", fmt.Errorf("field %q: negative indexes are not supported", string(id))
+		case seg.Kind != query.FieldSegment && seg.Kind != query.KeySegment:
+			return "", fmt.Errorf("invalid field %q", string(id))
+		case seg.Name == "" || strings.Contains(seg.Name, ".") || strings.HasPrefix(seg.Name, "$"):
+			return "", fmt.Errorf("field %q: name %q can't be used in filters", string(id), seg.Name)
+		default:
+			b.WriteString(seg.Name)
+		}
+	}
+
+	if b.Len() == 0 {
+		return "", fmt.Errorf("invalid field %q", string(id))
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,74 @@
+package mongo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/internal/rendertest"
+	"go.tomakado.io/dumbql/mongo"
+	"go.tomakado.io/dumbql/query"
+)
+
+func TestRenderer_Filter(t *testing.T) {
+	tests := []struct {
+		golden   string
+		input    string
+		renderer mongo.Renderer
+	}{
+		{golden: "eq", input: `status:open`},
+		{golden: "in", input: `status:[open, closed] and id!=[1, 2]`},
+		{golden: "ne", input: `status!=open`},
+		{golden: "range", input: `age>=18 and age<65.5 and score>1 and score<=2`},
+		{golden: "exists", input: `email? and not phone?`},
+		{golden: "regex", input: `name~"j.o*(hn)?"`},
+		{golden: "regex_one_of", input: `name~[jo, an] or name~[]`},
+		{golden: "regex_prefix", input: `name~jo`, renderer: mongo.Renderer{Like: query.LikePrefix}},
+		{golden: "regex_suffix", input: `name~jo`, renderer: mongo.Renderer{Like: query.LikeSuffix}},
+		{golden: "bool", input: `(a:1 or b:true or c:x) and not (d:1 and e:2)`},
+		{golden: "all", input: `tags @> [a, b] and labels @> []`},
+		{golden: "paths", input: "address.city:Madrid and items[0].sku:X and labels.`app/name`:web"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.golden, func(t *testing.T) {
+			filter, err := test.renderer.Filter(rendertest.MustParse(t, test.input))
+			require.NoError(t, err)
+
+			rendertest.Golden(t, test.golden, filter)
+		})
+	}
+}
+
+func TestRenderer_Filter_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr query.Expr
+	}{
+		{name: "nil", expr: nil},
+		{name: "typed nil", expr: (*query.BinaryExpr)(nil)},
+		{name: "nil operand", expr: &query.NotExpr{}},
+		{name: "negative index", expr: rendertest.MustParse(t, `items[-1].sku:x`)},
+		{name: "dotted name", expr: rendertest.MustParse(t, "labels.`app.kubernetes.io/name`:web")},
+		{name: "operator name", expr: rendertest.MustParse(t, `attributes["$where"]:x`)},
+		{name: "range of one-of", expr: rendertest.MustParse(t, `age>[1, 2]`)},
+		{name: "nil value", expr: &query.FieldExpr{Field: "a", Op: query.Equal}},
+		{name: "unknown operator", expr: &query.FieldExpr{Field: "a", Op: 42, Value: &query.StringLiteral{}}},
+		{
+			name: "unknown boolean operator",
+			expr: &query.BinaryExpr{Left: rendertest.MustParse(t, `a:1`), Op: 42, Right: rendertest.MustParse(t, `b:1`)},
+		},
+		{
+			name: "nested one-of",
+			expr: &query.FieldExpr{Field: "a", Op: query.Equal, Value: &query.OneOfExpr{
+				Values: []query.Valuer{&query.OneOfExpr{}},
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := mongo.Renderer{}.Filter(test.expr)
+			require.Error(t, err)
+		})
+	}
+}
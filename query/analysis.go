@@ -0,0 +1,108 @@
+package query
+
+import (
+	"go.tomakado.io/dumbql/schema"
+)
+
+// AnalysisOption configures Lint and Implies.
+type AnalysisOption func(*analysis)
+
+// WithColumnTypes declares the types of the fields. Fields with Array set hold several values and match
+// if any of them matches, the way array columns and slices do, e.g. `tags:a and tags:b` matches tags
+// holding both values. Lint and Implies only draw conclusions which hold for such fields.
+//
+// Fields compared with `@>` anywhere in the analyzed expressions are treated as arrays without being declared.
+func WithColumnTypes(types schema.ColumnTypes) AnalysisOption {
+	return func(a *analysis) {
+		for field, typ := range types {
+			if !typ.Array {
+				continue
+			}
+
+			if id, err := canonicalIdentifier(string(field)); err == nil {
+				a.arrays[id] = struct{}{}
+			}
+		}
+	}
+}
+
+// WithLikeMode declares the mode `~` is matched in, LikeContains by default. It must be the mode of
+// the StructMatcher or the renderer the expressions are evaluated with, e.g. `name~john` implies `name~oh`
+// in LikeContains mode only.
+func WithLikeMode(mode LikeMode) AnalysisOption {
+	return func(a *analysis) {
+		a.like = mode
+	}
+}
+
+// analysis holds the knowledge about fields shared by Lint and Implies.
+type analysis struct {
+	arrays map[Identifier]struct{} // Canonical names of fields holding several values
+	like   LikeMode                // Mode `~` is matched in
+}
+
+func newAnalysis(opts []AnalysisOption, exprs ...Expr) *analysis {
+	a := &analysis{arrays: make(map[Identifier]struct{})}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	for _, expr := range exprs {
+		if isNil(expr) {
+			continue
+		}
+
+		Inspect(expr, func(node Node) bool {
+			if f, ok := node.(*FieldExpr); ok && f != nil && f.Op == Contains {
+				if id, err := canonicalIdentifier(string(f.Field)); err == nil {
+					a.arrays[id] = struct{}{}
+				}
+			}
+
+			return true
+		})
+	}
+
+	return a
+}
+
+// multiValued reports whether the field may hold several values.
+func (a *analysis) multiValued(field Identifier) bool {
+	id, err := canonicalIdentifier(string(field))
+	if err != nil {
+		return true // Malformed paths can't be reasoned about
+	}
+
+	_, ok := a.arrays[id]
+
+	return ok
+}
+
+// matches reports whether a field holding target matches value with op.
+func (a *analysis) matches(target any, value Valuer, op FieldOperator) bool {
+	if op != Like {
+		return value.Match(target, op)
+	}
+
+	str, ok := target.(string)
+	if !ok {
+		return false
+	}
+
+	switch v := value.(type) {
+	case *StringLiteral:
+		return a.like.Match(str, v.StringValue)
+	case Identifier:
+		return a.like.Match(str, string(v))
+	case *OneOfExpr:
+		for _, item := range v.Values {
+			if a.matches(target, item, op) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
@@ -10,6 +10,13 @@ import (
 
 //go:generate go run github.com/mna/pigeon@v1.3.0 -optimize-grammar -optimize-parser -o parser.gen.go grammar.peg
 
+// Expr is an expression of the query AST.
+//
+// Expressions are treated as immutable: functions and methods of this package never modify the expressions
+// passed to them, so a parsed AST can be cached and shared between goroutines. Results of functions like
+// Rewrite, Simplify or FieldMapping.Apply may share unchanged nodes with their input, which is safe as long
+// as neither is modified. Validate and Clone always return new nodes. Clone an expression before modifying
+// it in place.
 type Expr interface {
 	fmt.Stringer
 	sq.Sqlizer
@@ -132,6 +139,7 @@ const (
 	LessThanOrEqual
 	Like
 	Exists
+	Contains
 )
 
 func (c FieldOperator) String() string {
@@ -152,6 +160,8 @@ func (c FieldOperator) String() string {
 		return "~"
 	case Exists:
 		return "exists"
+	case Contains:
+		return "@>"
 	default:
 		return "unknown!"
 	}
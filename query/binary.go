@@ -0,0 +1,354 @@
+package query
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// BinaryVersion is the version of the binary encoding written by MarshalBinary. UnmarshalBinary rejects
+// data of other versions.
+const BinaryVersion = 1
+
+// MaxBinaryDepth limits the nesting of expressions decoded by UnmarshalBinary.
+const MaxBinaryDepth = 4096
+
+// ErrMalformedBinary is returned by UnmarshalBinary for data which is not a valid encoding.
+var ErrMalformedBinary = errors.New("malformed binary query")
+
+const binaryMagic = "DQL"
+
+// Node tags of the binary encoding.
+const (
+	tagAnd byte = iota + 1
+	tagOr
+	tagNot
+	tagField
+	tagString
+	tagNumber
+	tagFalse
+	tagTrue
+	tagIdentifier
+	tagOneOf
+)
+
+// MarshalBinary encodes the expression into a compact binary form: the "DQL" magic and the version byte
+// followed by the nodes in prefix order. Every node starts with a tag byte; strings are prefixed with their
+// uvarint length, numbers are 8-byte little-endian IEEE 754 values and one-of lists are prefixed with their
+// uvarint length.
+func MarshalBinary(expr Expr) ([]byte, error) {
+	buf := append([]byte(binaryMagic), BinaryVersion)
+
+	return appendExpr(buf, expr)
+}
+
+func appendExpr(buf []byte, expr Expr) ([]byte, error) {
+	if isNil(expr) {
+		return nil, errors.New("binary: nil expression")
+	}
+
+	var err error
+
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		switch e.Op {
+		case And:
+			buf = append(buf, tagAnd)
+		case Or:
+			buf = append(buf, tagOr)
+		default:
+			return nil, fmt.Errorf("binary: unknown boolean operator %q", e.Op)
+		}
+
+		if buf, err = appendExpr(buf, e.Left); err != nil {
+			return nil, err
+		}
+
+		return appendExpr(buf, e.Right)
+	case *NotExpr:
+		return appendExpr(append(buf, tagNot), e.Expr)
+	case *FieldExpr:
+		if _, ok := fieldOperators[e.Op]; !ok {
+			return nil, fmt.Errorf("binary: unknown field operator %q", e.Op)
+		}
+
+		buf = appendString(append(buf, tagField, byte(e.Op)), string(e.Field))
+
+		return appendValue(buf, e.Value, true)
+	default:
+		return nil, fmt.Errorf("binary: unsupported expression %T", expr)
+	}
+}
+
+func appendValue(buf []byte, v Valuer, allowOneOf bool) ([]byte, error) {
+	if isNil(v) {
+		return nil, errors.New("binary: nil value")
+	}
+
+	switch val := v.(type) {
+	case *StringLiteral:
+		return appendString(append(buf, tagString), val.StringValue), nil
+	case *NumberLiteral:
+		return binary.LittleEndian.AppendUint64(append(buf, tagNumber), math.Float64bits(val.NumberValue)), nil
+	case *BoolLiteral:
+		if val.BoolValue {
+			return append(buf, tagTrue), nil
+		}
+		return append(buf, tagFalse), nil
+	case Identifier:
+		return appendString(append(buf, tagIdentifier), string(val)), nil
+	case *OneOfExpr:
+		if !allowOneOf {
+			return nil, errors.New("binary: nested one-of expressions are not supported")
+		}
+
+		buf = binary.AppendUvarint(append(buf, tagOneOf), uint64(len(val.Values)))
+
+		var err error
+		for _, item := range val.Values {
+			if buf, err = appendValue(buf, item, false); err != nil {
+				return nil, err
+			}
+		}
+
+		return buf, nil
+	default:
+		return nil, fmt.Errorf("binary: unsupported value %T", v)
+	}
+}
+
+func appendString(buf []byte, s string) []byte {
+	return append(binary.AppendUvarint(buf, uint64(len(s))), s...)
+}
+
+// UnmarshalBinary decodes an expression encoded with MarshalBinary. Decoding is not zero-copy: the input
+// is copied once and the string values of the expression share that copy, so the input can be reused
+// afterwards. Field paths are rewritten in canonical form, the same way UnmarshalJSON does, so expressions
+// decoded from either encoding compare equal with Equals and Hash.
+//
+// Decoding is bounded by the input: lengths are checked against the remaining data before allocating,
+// nesting is limited with MaxBinaryDepth and trailing data is rejected. Errors wrap ErrMalformedBinary.
+func UnmarshalBinary(data []byte) (Expr, error) {
+	if len(data) < len(binaryMagic)+1 || string(data[:len(binaryMagic)]) != binaryMagic {
+		return nil, fmt.Errorf("%w: missing header", ErrMalformedBinary)
+	}
+
+	if v := data[len(binaryMagic)]; v != BinaryVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrMalformedBinary, v)
+	}
+
+	d := &binaryDecoder{data: string(data[len(binaryMagic)+1:])}
+
+	expr, err := d.expr(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.off != len(d.data) {
+		return nil, d.errorf("unexpected data after expression")
+	}
+
+	return expr, nil
+}
+
+type binaryDecoder struct {
+	data string
+	off  int
+}
+
+func (d *binaryDecoder) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w at offset %d: %s", ErrMalformedBinary, d.off+len(binaryMagic)+1, fmt.Sprintf(format, args...))
+}
+
+func (d *binaryDecoder) byte() (byte, error) {
+	if d.off >= len(d.data) {
+		return 0, d.errorf("unexpected end of data")
+	}
+
+	b := d.data[d.off]
+	d.off++
+
+	return b, nil
+}
+
+func (d *binaryDecoder) uvarint() (uint64, error) {
+	var (
+		x     uint64
+		shift uint
+	)
+
+	for i := range binary.MaxVarintLen64 {
+		b, err := d.byte()
+		if err != nil {
+			return 0, err
+		}
+
+		if b < 0x80 { //nolint:mnd
+			if i == binary.MaxVarintLen64-1 && b > 1 {
+				return 0, d.errorf("varint overflow")
+			}
+			return x | uint64(b)<<shift, nil
+		}
+
+		x |= uint64(b&0x7f) << shift //nolint:mnd
+		shift += 7
+	}
+
+	return 0, d.errorf("varint overflow")
+}
+
+// length reads a length and checks that the remaining data holds at least minSize bytes per item.
+func (d *binaryDecoder) length(minSize int) (int, error) {
+	n, err := d.uvarint()
+	if err != nil {
+		return 0, err
+	}
+
+	if n > uint64((len(d.data)-d.off)/minSize) {
+		return 0, d.errorf("length %d exceeds remaining data", n)
+	}
+
+	return int(n), nil
+}
+
+func (d *binaryDecoder) string() (string, error) {
+	n, err := d.length(1)
+	if err != nil {
+		return "", err
+	}
+
+	s := d.data[d.off : d.off+n] // Shares the copy of the input
+	d.off += n
+
+	return s, nil
+}
+
+func (d *binaryDecoder) expr(depth int) (Expr, error) {
+	if depth > MaxBinaryDepth {
+		return nil, d.errorf("expression is nested too deep")
+	}
+
+	tag, err := d.byte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case tagAnd, tagOr:
+		left, err := d.expr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := d.expr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		op := And
+		if tag == tagOr {
+			op = Or
+		}
+
+		return &BinaryExpr{Left: left, Op: op, Right: right}, nil
+	case tagNot:
+		expr, err := d.expr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return &NotExpr{Expr: expr}, nil
+	case tagField:
+		return d.field()
+	default:
+		d.off--
+		return nil, d.errorf("unknown expression tag %d", tag)
+	}
+}
+
+func (d *binaryDecoder) field() (Expr, error) {
+	op, err := d.byte()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := fieldOperators[FieldOperator(op)]; !ok {
+		return nil, d.errorf("unknown field operator %d", op)
+	}
+
+	name, err := d.string()
+	if err != nil {
+		return nil, err
+	}
+
+	field, err := canonicalIdentifier(name)
+	if err != nil || name == "" {
+		return nil, d.errorf("invalid field path %q", name)
+	}
+
+	value, err := d.value(true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FieldExpr{Field: field, Op: FieldOperator(op), Value: value}, nil
+}
+
+func (d *binaryDecoder) value(allowOneOf bool) (Valuer, error) {
+	tag, err := d.byte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case tagString:
+		s, err := d.string()
+		return &StringLiteral{StringValue: s}, err
+	case tagIdentifier:
+		s, err := d.string()
+		return Identifier(s), err
+	case tagNumber:
+		if len(d.data)-d.off < 8 { //nolint:mnd
+			return nil, d.errorf("unexpected end of data")
+		}
+
+		bits := binary.LittleEndian.Uint64([]byte(d.data[d.off : d.off+8]))
+		d.off += 8
+
+		return &NumberLiteral{NumberValue: math.Float64frombits(bits)}, nil
+	case tagFalse, tagTrue:
+		return &BoolLiteral{BoolValue: tag == tagTrue}, nil
+	case tagOneOf:
+		if !allowOneOf {
+			return nil, d.errorf("nested one-of expressions are not supported")
+		}
+		return d.oneOf()
+	default:
+		d.off--
+		return nil, d.errorf("unknown value tag %d", tag)
+	}
+}
+
+func (d *binaryDecoder) oneOf() (Valuer, error) {
+	n, err := d.length(1) // Every value takes at least its tag byte
+	if err != nil {
+		return nil, err
+	}
+
+	var values []Valuer // Nil for empty lists, the same way the parser builds them
+	if n > 0 {
+		values = make([]Valuer, 0, n)
+	}
+
+	for range n {
+		v, err := d.value(false)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, v)
+	}
+
+	return &OneOfExpr{Values: values}, nil
+}
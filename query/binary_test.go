@@ -0,0 +1,194 @@
+package query_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	expr := mustParse(t, `a:x and not b>=2`)
+
+	data, err := query.MarshalBinary(expr)
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte{
+		'D', 'Q', 'L', 1, // Header
+		1,                       // And
+		4, 1, 1, 'a', 5, 1, 'x', // a = "x"
+		3,                                        // Not
+		4, 4, 1, 'b', 6, 0, 0, 0, 0, 0, 0, 0, 64, // b >= 2
+	}, data)
+
+	got, err := query.UnmarshalBinary(data)
+	require.NoError(t, err)
+	assert.Equal(t, expr, got)
+}
+
+func TestMarshalBinary_RoundTrip(t *testing.T) {
+	queries := []string{
+		`a:1`,
+		`a!=-0.25 or b<3 or c<=4 or d>5`,
+		`name~"jo\"hn" and tags:[] and items[0].sku:X and attributes["color"]:red`,
+		`not not enabled:false`,
+		`v:[1, true, "x"]`,
+		`tags @> [a, b] and not tags @> c`,
+		`ok and (a:1 or b:2) and not c exists`,
+		`labels.` + "`app/name`" + `:"ünïcode"`,
+	}
+
+	for _, q := range queries {
+		t.Run(q, func(t *testing.T) {
+			expr := mustParse(t, q)
+
+			data, err := query.MarshalBinary(expr)
+			require.NoError(t, err)
+
+			got, err := query.UnmarshalBinary(data)
+			require.NoError(t, err)
+			assert.Equal(t, expr, got)
+		})
+	}
+}
+
+func TestUnmarshalBinary_CopiesInput(t *testing.T) {
+	data, err := query.MarshalBinary(mustParse(t, `name:john`))
+	require.NoError(t, err)
+
+	expr, err := query.UnmarshalBinary(data)
+	require.NoError(t, err)
+
+	for i := range data {
+		data[i] = 0
+	}
+
+	assert.Equal(t, `(= name "john")`, expr.String())
+}
+
+func TestUnmarshalBinary_CanonicalFields(t *testing.T) {
+	expr := &query.FieldExpr{Field: "`user`[\"name\"]", Op: query.Equal, Value: &query.StringLiteral{StringValue: "x"}}
+
+	data, err := query.MarshalBinary(expr)
+	require.NoError(t, err)
+
+	fromBinary, err := query.UnmarshalBinary(data)
+	require.NoError(t, err)
+
+	data, err = query.MarshalJSON(expr)
+	require.NoError(t, err)
+
+	fromJSON, err := query.UnmarshalJSON(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, fromJSON, fromBinary)
+	assert.Equal(t, `(= user["name"] "x")`, fromBinary.String())
+	assert.Equal(t, query.Hash(fromJSON), query.Hash(fromBinary))
+}
+
+func TestMarshalBinary_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr query.Expr
+	}{
+		{name: "nil", expr: nil},
+		{name: "nil operand", expr: &query.NotExpr{}},
+		{name: "nil value", expr: &query.FieldExpr{Field: "a", Op: query.Equal}},
+		{name: "unknown operator", expr: &query.FieldExpr{Field: "a", Op: 42, Value: &query.BoolLiteral{}}},
+		{
+			name: "nested one-of",
+			expr: &query.FieldExpr{
+				Field: "a",
+				Op:    query.Equal,
+				Value: &query.OneOfExpr{Values: []query.Valuer{&query.OneOfExpr{}}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := query.MarshalBinary(test.expr)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestUnmarshalBinary_Errors(t *testing.T) { //nolint:funlen
+	header := []byte{'D', 'Q', 'L', 1}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "bad magic", data: []byte{'D', 'Q', 'X', 1, 4, 1, 1, 'a', 7}},
+		{name: "unsupported version", data: []byte{'D', 'Q', 'L', 2, 4, 1, 1, 'a', 7}},
+		{name: "no expression", data: header},
+		{name: "unknown expression tag", data: append(header, 42)},
+		{name: "value instead of expression", data: append(header, 7)},
+		{name: "truncated binary", data: append(header, 1, 4, 1, 1, 'a', 7)},
+		{name: "unknown field operator", data: append(header, 4, 42, 1, 'a', 7)},
+		{name: "truncated field", data: append(header, 4, 1, 5, 'a')},
+		{name: "empty field path", data: append(header, 4, 1, 0, 7)},
+		{name: "invalid field path", data: append(header, 4, 1, 2, 'a', '[', 7)},
+		{name: "unknown value tag", data: append(header, 4, 1, 1, 'a', 42)},
+		{name: "expression instead of value", data: append(header, 4, 1, 1, 'a', 3)},
+		{name: "truncated number", data: append(header, 4, 1, 1, 'a', 6, 0, 0, 0)},
+		{name: "string length exceeds data", data: append(header, 4, 1, 1, 'a', 5, 0xff, 0xff, 0xff, 0xff, 0x0f)},
+		{
+			name: "varint overflow",
+			data: append(header, 4, 1, 1, 'a', 5, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f),
+		},
+		{name: "one-of length exceeds data", data: append(header, 4, 1, 1, 'a', 10, 0xff, 0xff, 0xff, 0xff, 0x0f, 7)},
+		{name: "nested one-of", data: append(header, 4, 1, 1, 'a', 10, 1, 10, 0)},
+		{name: "trailing data", data: append(header, 4, 1, 1, 'a', 7, 7)},
+		{
+			name: "too deep",
+			data: append(append(header, bytes.Repeat([]byte{3}, query.MaxBinaryDepth+1)...), 4, 1, 1, 'a', 7),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expr, err := query.UnmarshalBinary(test.data)
+			require.ErrorIs(t, err, query.ErrMalformedBinary)
+			assert.Nil(t, expr)
+		})
+	}
+}
+
+func TestUnmarshalBinary_MaxDepth(t *testing.T) {
+	data := append([]byte{'D', 'Q', 'L', 1}, bytes.Repeat([]byte{3}, query.MaxBinaryDepth)...)
+	data = append(data, 4, 1, 1, 'a', 7)
+
+	_, err := query.UnmarshalBinary(data)
+	require.NoError(t, err)
+}
+
+func FuzzUnmarshalBinary(f *testing.F) {
+	for _, q := range []string{`a:1`, `a:x and not b:[1, true, "y"]`, `c exists or d~e`} {
+		data, err := query.MarshalBinary(mustParse(f, q))
+		require.NoError(f, err)
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		expr, err := query.UnmarshalBinary(data)
+		if err != nil {
+			return
+		}
+
+		// Every accepted expression survives another round trip. Encodings are compared, since NaN != NaN.
+		data, err = query.MarshalBinary(expr)
+		require.NoError(t, err)
+
+		got, err := query.UnmarshalBinary(data)
+		require.NoError(t, err)
+
+		again, err := query.MarshalBinary(got)
+		require.NoError(t, err)
+		assert.Equal(t, data, again)
+	})
+}
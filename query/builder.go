@@ -0,0 +1,181 @@
+package query
+
+import "fmt"
+
+// Builder composes expressions programmatically, producing the same nodes as the parser, e.g.
+//
+//	query.F("age").Gte(18).And(query.F("city").In("BCN", "MAD")).Expr()
+//
+// is equal to the result of parsing `age >= 18 and city:[BCN, MAD]`. Builder is not an Expr itself,
+// Expr returns the built tree. Existing expressions, e.g. parsed user queries, are combined with From.
+//
+// The zero Builder holds no expression and is skipped when combined with others, so conditions can be
+// accumulated starting from it:
+//
+//	var filter query.Builder
+//	if tenantID != "" {
+//		filter = filter.And(query.F("tenant_id").Eq(tenantID))
+//	}
+type Builder struct {
+	expr Expr
+}
+
+// From starts a Builder with the expression. A nil expression yields the zero Builder.
+func From(expr Expr) Builder {
+	if isNil(expr) {
+		return Builder{}
+	}
+
+	return Builder{expr}
+}
+
+// AllOf joins the builders with `and`, skipping empty ones.
+func AllOf(builders ...Builder) Builder {
+	return Builder{}.And(builders...)
+}
+
+// AnyOf joins the builders with `or`, skipping empty ones.
+func AnyOf(builders ...Builder) Builder {
+	return Builder{}.Or(builders...)
+}
+
+// Not negates the builder. Negating an empty builder yields an empty one.
+func Not(b Builder) Builder {
+	return b.Not()
+}
+
+// Expr returns the built expression, nil for an empty Builder.
+func (b Builder) Expr() Expr {
+	return b.expr
+}
+
+// And joins the expression with others using `and` into a left-associative chain, the way the parser does.
+func (b Builder) And(builders ...Builder) Builder {
+	return b.join(And, builders)
+}
+
+// Or joins the expression with others using `or` into a left-associative chain, the way the parser does.
+func (b Builder) Or(builders ...Builder) Builder {
+	return b.join(Or, builders)
+}
+
+// Not negates the expression.
+func (b Builder) Not() Builder {
+	if b.expr == nil {
+		return Builder{}
+	}
+
+	return Builder{&NotExpr{Expr: b.expr}}
+}
+
+func (b Builder) join(op BooleanOperator, builders []Builder) Builder {
+	res := b.expr
+
+	for _, other := range builders {
+		switch {
+		case other.expr == nil:
+		case res == nil:
+			res = other.expr
+		default:
+			res = &BinaryExpr{Left: res, Op: op, Right: other.expr}
+		}
+	}
+
+	return Builder{res}
+}
+
+// FieldBuilder creates field expressions for a single field, see F.
+type FieldBuilder struct {
+	field Identifier
+}
+
+// F starts a field expression for the field path, written the same way as in a query, e.g. `address.city`,
+// "labels.`app.kubernetes.io/name`" or `items[0].sku`. It panics if the path is malformed.
+//
+// Values passed to the FieldBuilder methods must be strings, booleans, numbers of any Go numeric type
+// or Valuer nodes, otherwise the methods panic.
+func F(field string) FieldBuilder {
+	id, err := canonicalIdentifier(field)
+	if err != nil {
+		panic(fmt.Sprintf("query.F: %v", err))
+	}
+
+	return FieldBuilder{field: id}
+}
+
+// Is creates a field expression with an arbitrary operator.
+func (f FieldBuilder) Is(op FieldOperator, value any) Builder {
+	return Builder{&FieldExpr{Field: f.field, Op: op, Value: toValuer(value)}}
+}
+
+// Eq creates a `field = value` expression.
+func (f FieldBuilder) Eq(value any) Builder { return f.Is(Equal, value) }
+
+// NotEq creates a `field != value` expression.
+func (f FieldBuilder) NotEq(value any) Builder { return f.Is(NotEqual, value) }
+
+// Gt creates a `field > value` expression.
+func (f FieldBuilder) Gt(value any) Builder { return f.Is(GreaterThan, value) }
+
+// Gte creates a `field >= value` expression.
+func (f FieldBuilder) Gte(value any) Builder { return f.Is(GreaterThanOrEqual, value) }
+
+// Lt creates a `field < value` expression.
+func (f FieldBuilder) Lt(value any) Builder { return f.Is(LessThan, value) }
+
+// Lte creates a `field <= value` expression.
+func (f FieldBuilder) Lte(value any) Builder { return f.Is(LessThanOrEqual, value) }
+
+// Like creates a `field ~ value` expression.
+func (f FieldBuilder) Like(value any) Builder { return f.Is(Like, value) }
+
+// Exists creates a `field?` expression.
+func (f FieldBuilder) Exists() Builder { return f.Is(Exists, true) }
+
+// True creates a `field = true` expression, the same as the `field` shorthand in a query.
+func (f FieldBuilder) True() Builder { return f.Is(Equal, true) }
+
+// In creates a `field:[values...]` expression.
+func (f FieldBuilder) In(values ...any) Builder {
+	return f.Is(Equal, toOneOf(values))
+}
+
+// NotIn creates a `not field:[values...]` expression.
+func (f FieldBuilder) NotIn(values ...any) Builder {
+	return f.In(values...).Not()
+}
+
+// Contains creates a `field @> [values...]` expression.
+func (f FieldBuilder) Contains(values ...any) Builder {
+	return f.Is(Contains, toOneOf(values))
+}
+
+func toOneOf(values []any) *OneOfExpr {
+	var vals []Valuer // Nil for empty lists, the same way the parser builds them
+
+	for _, v := range values {
+		vals = append(vals, toValuer(v))
+	}
+
+	return &OneOfExpr{Values: vals}
+}
+
+func toValuer(value any) Valuer {
+	switch v := value.(type) {
+	case Valuer:
+		if isNil(v) {
+			panic("query: nil value")
+		}
+		return v
+	case string:
+		return &StringLiteral{StringValue: v}
+	case bool:
+		return &BoolLiteral{BoolValue: v}
+	}
+
+	if n, ok := convertToFloat64(value); ok {
+		return &NumberLiteral{NumberValue: n}
+	}
+
+	panic(fmt.Sprintf("query: unsupported value type %T", value))
+}
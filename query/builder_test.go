@@ -0,0 +1,157 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+)
+
+func TestBuilder(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		name  string
+		build query.Builder
+		want  string
+	}{
+		{
+			name:  "example",
+			build: query.F("age").Gte(18).And(query.F("city").In("BCN", "MAD")),
+			want:  `age >= 18 and city:[BCN, MAD]`,
+		},
+		{
+			name:  "equality",
+			build: query.F("status").Eq("new").Or(query.F("status").NotEq("old")),
+			want:  `status:new or status!="old"`,
+		},
+		{
+			name: "comparisons",
+			build: query.AllOf(
+				query.F("a").Gt(int8(1)),
+				query.F("b").Gte(uint64(2)),
+				query.F("c").Lt(float32(0.5)),
+				query.F("d").Lte(-4),
+			),
+			want: `a>1 and b>=2 and c<0.5 and d<=-4`,
+		},
+		{
+			name:  "like",
+			build: query.F("name").Like("jo"),
+			want:  `name~jo`,
+		},
+		{
+			name:  "exists",
+			build: query.F("email").Exists().And(query.F("phone").Exists().Not()),
+			want:  `email? and not phone exists`,
+		},
+		{
+			name:  "booleans",
+			build: query.F("verified").True().And(query.F("banned").Eq(false)),
+			want:  `verified and banned:false`,
+		},
+		{
+			name:  "one-of",
+			build: query.F("n").In(1, 2.5, true, "x").And(query.F("empty").In()),
+			want:  `n:[1, 2.5, true, "x"] and empty:[]`,
+		},
+		{
+			name:  "not in",
+			build: query.F("role").NotIn("admin"),
+			want:  `not role:[admin]`,
+		},
+		{
+			name:  "contains",
+			build: query.F("tags").Contains("urgent", "bug"),
+			want:  `tags @> [urgent, bug]`,
+		},
+		{
+			name:  "negation of a group",
+			build: query.Not(query.AnyOf(query.F("a").Eq(1), query.F("b").Eq(2))),
+			want:  `not (a:1 or b:2)`,
+		},
+		{
+			name: "left-associative chains",
+			build: query.F("a").Eq(1).Or(
+				query.F("b").Eq(2),
+				query.F("c").Eq(3).And(query.F("d").Eq(4)),
+			),
+			want: `a:1 or b:2 or (c:3 and d:4)`,
+		},
+		{
+			name:  "paths",
+			build: query.F("labels.`app/name`").Eq("web").And(query.F(`items[ 0 ].sku`).Eq("X")),
+			want:  "labels.`app/name`:web and items[0].sku:X",
+		},
+		{
+			name: "valuers",
+			build: query.F("a").Eq(&query.NumberLiteral{NumberValue: 1}).
+				And(query.F("c").Is(query.Equal, &query.StringLiteral{StringValue: "d"})),
+			want: `a:1 and c:d`,
+		},
+		{
+			name:  "empty builders are skipped",
+			build: query.Builder{}.And(query.Builder{}, query.F("a").Eq(1), query.AllOf()).Or(query.Not(query.Builder{})),
+			want:  `a:1`,
+		},
+		{
+			name:  "wrapped expressions",
+			build: query.AllOf(query.From(mustParse(t, `a:1`)), query.From(nil), query.From(mustParse(t, `b:2`))),
+			want:  `a:1 and b:2`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, mustParse(t, test.want), test.build.Expr())
+		})
+	}
+}
+
+func TestBuilder_Expr(t *testing.T) {
+	expr := query.F("age").Gte(18).And(query.F("city").In("BCN", "MAD")).Expr()
+	parsed := mustParse(t, `age >= 18 and city:[BCN, MAD]`)
+
+	want, err := query.Format(parsed)
+	require.NoError(t, err)
+
+	formatted, err := query.Format(expr)
+	require.NoError(t, err)
+	assert.Equal(t, want, formatted)
+
+	assert.True(t, query.Equals(expr, parsed))
+	assert.Equal(t, query.Hash(parsed), query.Hash(expr))
+
+	data, err := query.MarshalJSON(expr)
+	require.NoError(t, err)
+
+	decoded, err := query.UnmarshalJSON(data)
+	require.NoError(t, err)
+	assert.Equal(t, parsed, decoded)
+
+	// Built trees can be placed into hand-built ones.
+	formatted, err = query.Format(&query.NotExpr{Expr: expr})
+	require.NoError(t, err)
+	assert.Equal(t, "not ("+want+")", formatted)
+}
+
+func TestBuilder_Identifier(t *testing.T) {
+	assert.Equal(t,
+		&query.FieldExpr{Field: "a", Op: query.Equal, Value: query.Identifier("b")},
+		query.F("a").Eq(query.Identifier("b")).Expr(),
+	)
+}
+
+func TestBuilder_Empty(t *testing.T) {
+	assert.Nil(t, query.AllOf().Expr())
+	assert.Nil(t, query.AnyOf(query.Builder{}).Expr())
+	assert.Nil(t, query.Builder{}.Not().Expr())
+	assert.Nil(t, query.From((*query.BinaryExpr)(nil)).Expr())
+}
+
+func TestBuilder_Panics(t *testing.T) {
+	assert.Panics(t, func() { query.F("a..b") })
+	assert.Panics(t, func() { query.F("") })
+	assert.Panics(t, func() { query.F("a").Eq(struct{}{}) })
+	assert.Panics(t, func() { query.F("a").In(nil) })
+	assert.Panics(t, func() { query.F("a").Eq((*query.StringLiteral)(nil)) })
+}
@@ -0,0 +1,55 @@
+package query
+
+// Clone returns a deep copy of the expression which shares no nodes with the input, so the copy can be
+// modified in place without affecting the input. Nil expressions and values are kept as is,
+// as are Expr and Valuer implementations from outside of this package, which can't be copied.
+func Clone(expr Expr) Expr {
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		if e == nil {
+			return e
+		}
+		return &BinaryExpr{Left: Clone(e.Left), Op: e.Op, Right: Clone(e.Right)}
+	case *NotExpr:
+		if e == nil {
+			return e
+		}
+		return &NotExpr{Expr: Clone(e.Expr)}
+	case *FieldExpr:
+		if e == nil {
+			return e
+		}
+		return &FieldExpr{Field: e.Field, Op: e.Op, Value: CloneValue(e.Value)}
+	default:
+		return expr
+	}
+}
+
+// CloneValue returns a deep copy of the value, see Clone.
+func CloneValue(v Valuer) Valuer {
+	if isNil(v) {
+		return v
+	}
+
+	switch val := v.(type) {
+	case *StringLiteral:
+		return &StringLiteral{StringValue: val.StringValue}
+	case *NumberLiteral:
+		return &NumberLiteral{NumberValue: val.NumberValue}
+	case *BoolLiteral:
+		return &BoolLiteral{BoolValue: val.BoolValue}
+	case *OneOfExpr:
+		if val.Values == nil {
+			return &OneOfExpr{}
+		}
+
+		values := make([]Valuer, len(val.Values))
+		for i, item := range val.Values {
+			values[i] = CloneValue(item)
+		}
+
+		return &OneOfExpr{Values: values}
+	default:
+		return v // Identifier is a string and can't be modified in place
+	}
+}
@@ -0,0 +1,77 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+)
+
+// pointers collects the pointer nodes of the AST, i.e. all nodes but identifiers.
+func pointers(expr query.Expr) map[query.Node]struct{} {
+	nodes := make(map[query.Node]struct{})
+
+	query.Inspect(expr, func(node query.Node) bool {
+		if _, ok := node.(query.Identifier); !ok && node != nil {
+			nodes[node] = struct{}{}
+		}
+		return true
+	})
+
+	return nodes
+}
+
+func assertNoSharedNodes(t *testing.T, a, b query.Expr) {
+	t.Helper()
+
+	bNodes := pointers(b)
+	for node := range pointers(a) {
+		_, shared := bNodes[node]
+		assert.False(t, shared, "%v is shared", node)
+	}
+}
+
+func TestClone(t *testing.T) {
+	queries := []string{
+		`a:1`,
+		`status:new and not (age>=18.5 or verified) and tags:[web, "api", 1, true] and x?`,
+		`tags:[]`,
+		`items[0].sku:X or attributes["color"]~red`,
+	}
+
+	for _, q := range queries {
+		t.Run(q, func(t *testing.T) {
+			expr := mustParse(t, q)
+
+			got := query.Clone(expr)
+			assert.Equal(t, expr, got)
+			assertNoSharedNodes(t, expr, got)
+		})
+	}
+}
+
+func TestClone_Modify(t *testing.T) {
+	expr := mustParse(t, `a:1 and b:[x, y]`)
+	want := expr.String()
+
+	clone := query.Clone(expr).(*query.BinaryExpr)
+	clone.Op = query.Or
+	clone.Left.(*query.FieldExpr).Value.(*query.NumberLiteral).NumberValue = 2
+	clone.Right.(*query.FieldExpr).Value.(*query.OneOfExpr).Values[0] = &query.StringLiteral{StringValue: "z"}
+
+	assert.Equal(t, want, expr.String())
+	assert.Equal(t, `(or (= a 2) (= b ["z" "y"]))`, clone.String())
+}
+
+func TestClone_Nil(t *testing.T) {
+	assert.Nil(t, query.Clone(nil))
+	assert.Nil(t, query.CloneValue(nil))
+
+	var field *query.FieldExpr
+	assert.Equal(t, query.Expr(field), query.Clone(field))
+
+	got := query.Clone(&query.NotExpr{Expr: &query.FieldExpr{Field: "a", Op: query.Exists}})
+	require.IsType(t, &query.NotExpr{}, got)
+	assert.Nil(t, got.(*query.NotExpr).Expr.(*query.FieldExpr).Value)
+}
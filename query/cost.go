@@ -0,0 +1,163 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+
+	"go.tomakado.io/dumbql/schema"
+)
+
+// ErrTooExpensive is returned by CostReport.Check when the query exceeds one of the limits.
+var ErrTooExpensive = errors.New("query is too expensive")
+
+// Default factors of the cost model.
+const (
+	DefaultLikeFactor      = 5
+	DefaultUnindexedFactor = 10
+	DefaultOneOfValueCost  = 0.1
+	DefaultDepthCost       = 1
+)
+
+// CostModel defines how Cost scores expressions. Zero factors are replaced with their defaults.
+type CostModel struct {
+	Fields schema.Costs // Per-field weights, fields missing here have weight 1 and are not indexed
+
+	LikeFactor      float64 // Multiplier for `~` comparisons
+	UnindexedFactor float64 // Multiplier for comparisons of fields which are not indexed
+	OneOfValueCost  float64 // Additional cost of every one-of value after the first one, relative to the clause cost
+	DepthCost       float64 // Cost of every nesting level
+}
+
+// CostReport is the result of Cost: the score and its breakdown.
+type CostReport struct {
+	Score float64
+
+	Clauses          int                // Number of field expressions
+	MaxDepth         int                // Nesting depth, `and` and `or` chains count as a single level
+	OneOfValues      int                // Total number of one-of values
+	LikeClauses      int                // Number of `~` comparisons
+	UnindexedClauses int                // Number of comparisons of fields which are not indexed
+	Fields           map[string]float64 // Cost of the clauses of every field
+}
+
+// CostLimits defines limits checked by CostReport.Check. Zero limits are not checked.
+type CostLimits struct {
+	MaxScore       float64
+	MaxClauses     int
+	MaxDepth       int
+	MaxOneOfValues int
+}
+
+// Cost estimates how expensive the expression is to run, so expensive user queries can be rejected
+// or throttled before running them.
+//
+// Every field expression costs the weight of its field, multiplied by LikeFactor for `~` comparisons
+// and by UnindexedFactor for fields which are not indexed. One-of lists add OneOfValueCost of the clause cost
+// for every value after the first one. The score is the sum of the clause costs plus DepthCost for every
+// nesting level. DumbQL has no regular expression operator, so `~` is the only pattern match accounted for.
+func Cost(expr Expr, model CostModel) CostReport {
+	model.defaults()
+
+	report := CostReport{Fields: make(map[string]float64)}
+	report.MaxDepth = model.expr(expr, &report)
+	report.Score += model.DepthCost * float64(report.MaxDepth)
+
+	return report
+}
+
+func (m *CostModel) defaults() {
+	if m.LikeFactor == 0 {
+		m.LikeFactor = DefaultLikeFactor
+	}
+	if m.UnindexedFactor == 0 {
+		m.UnindexedFactor = DefaultUnindexedFactor
+	}
+	if m.OneOfValueCost == 0 {
+		m.OneOfValueCost = DefaultOneOfValueCost
+	}
+	if m.DepthCost == 0 {
+		m.DepthCost = DefaultDepthCost
+	}
+}
+
+// expr adds the costs of the clauses of expr to the report and returns the nesting depth of expr.
+func (m *CostModel) expr(expr Expr, report *CostReport) int {
+	var depth int
+
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		if e == nil {
+			return 0
+		}
+
+		for _, operand := range junction(e, e.Op) {
+			depth = max(depth, m.expr(operand, report))
+		}
+	case *NotExpr:
+		if e == nil {
+			return 0
+		}
+
+		depth = m.expr(e.Expr, report)
+	case *FieldExpr:
+		if e == nil {
+			return 0
+		}
+
+		m.field(e, report)
+	case nil:
+		return 0
+	}
+
+	return depth + 1
+}
+
+func (m *CostModel) field(f *FieldExpr, report *CostReport) {
+	name := f.Field
+	if canonical, err := canonicalIdentifier(string(name)); err == nil {
+		name = canonical
+	}
+
+	fc := m.Fields[schema.Field(name)]
+
+	cost := fc.Weight
+	if cost == 0 {
+		cost = 1
+	}
+
+	report.Clauses++
+
+	if f.Op == Like {
+		cost *= m.LikeFactor
+		report.LikeClauses++
+	}
+
+	if !fc.Indexed {
+		cost *= m.UnindexedFactor
+		report.UnindexedClauses++
+	}
+
+	if oneOf, ok := f.Value.(*OneOfExpr); ok && oneOf != nil {
+		report.OneOfValues += len(oneOf.Values)
+		cost += cost * m.OneOfValueCost * float64(max(len(oneOf.Values)-1, 0))
+	}
+
+	report.Fields[string(name)] += cost
+	report.Score += cost
+}
+
+// Check returns an error wrapping ErrTooExpensive if the report exceeds any of the limits.
+func (r CostReport) Check(limits CostLimits) error {
+	switch {
+	case limits.MaxScore > 0 && r.Score > limits.MaxScore:
+		return fmt.Errorf("%w: score %g exceeds %g", ErrTooExpensive, r.Score, limits.MaxScore)
+	case limits.MaxClauses > 0 && r.Clauses > limits.MaxClauses:
+		return fmt.Errorf("%w: %d clauses exceed %d", ErrTooExpensive, r.Clauses, limits.MaxClauses)
+	case limits.MaxDepth > 0 && r.MaxDepth > limits.MaxDepth:
+		return fmt.Errorf("%w: depth %d exceeds %d", ErrTooExpensive, r.MaxDepth, limits.MaxDepth)
+	case limits.MaxOneOfValues > 0 && r.OneOfValues > limits.MaxOneOfValues:
+		return fmt.Errorf("%w: %d one-of values exceed %d", ErrTooExpensive, r.OneOfValues, limits.MaxOneOfValues)
+	default:
+		return nil
+	}
+}
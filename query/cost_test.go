@@ -0,0 +1,115 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+	"go.tomakado.io/dumbql/schema"
+)
+
+func TestCost(t *testing.T) { //nolint:funlen
+	model := query.CostModel{
+		Fields: schema.Costs{
+			"id":     {Weight: 1, Indexed: true},
+			"status": {Weight: 2, Indexed: true},
+			"bio":    {Weight: 3},
+		},
+	}
+
+	tests := []struct {
+		input string
+		want  query.CostReport
+	}{
+		{
+			input: `id:1`,
+			want: query.CostReport{
+				Score:    2, // 1 for the clause, 1 for the depth
+				Clauses:  1,
+				MaxDepth: 1,
+				Fields:   map[string]float64{"id": 1},
+			},
+		},
+		{
+			input: `bio~rust`,
+			want: query.CostReport{
+				Score:            151, // 3 * 5 * 10
+				Clauses:          1,
+				MaxDepth:         1,
+				LikeClauses:      1,
+				UnindexedClauses: 1,
+				Fields:           map[string]float64{"bio": 150},
+			},
+		},
+		{
+			input: `status:[a, b, c, d, e, f] and id > 10 and other:1`,
+			want: query.CostReport{
+				Score:            2*1.5 + 1 + 10 + 2,
+				Clauses:          3,
+				MaxDepth:         2,
+				OneOfValues:      6,
+				UnindexedClauses: 1,
+				Fields:           map[string]float64{"status": 3, "id": 1, "other": 10},
+			},
+		},
+		{
+			input: "id:1 and (status:a or not (id:2 and `id`:3))",
+			want: query.CostReport{
+				Score:    1 + 2 + 1 + 1 + 5,
+				Clauses:  4,
+				MaxDepth: 5,
+				Fields:   map[string]float64{"id": 3, "status": 2},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			got := query.Cost(mustParse(t, test.input), model)
+			assert.InDelta(t, test.want.Score, got.Score, 1e-9)
+
+			test.want.Score = got.Score
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestCost_Factors(t *testing.T) {
+	model := query.CostModel{
+		LikeFactor:      2,
+		UnindexedFactor: 3,
+		OneOfValueCost:  1,
+		DepthCost:       100,
+	}
+
+	got := query.Cost(mustParse(t, `a~[x, y]`), model)
+
+	assert.InDelta(t, 1*2*3*2+100, got.Score, 1e-9)
+	assert.Equal(t, 0, query.Cost(nil, model).MaxDepth)
+}
+
+func TestCost_NilOperand(t *testing.T) {
+	expr := &query.BinaryExpr{Left: (*query.BinaryExpr)(nil), Op: query.And, Right: mustParse(t, `a:1`)}
+
+	got := query.Cost(expr, query.CostModel{})
+
+	assert.Equal(t, 1, got.Clauses)
+}
+
+func TestCostReport_Check(t *testing.T) {
+	report := query.Cost(mustParse(t, `a:[1, 2, 3] and (b:1 or c:2)`), query.CostModel{})
+
+	require.NoError(t, report.Check(query.CostLimits{}))
+	require.NoError(t, report.Check(query.CostLimits{MaxScore: 100, MaxClauses: 3, MaxDepth: 3, MaxOneOfValues: 3}))
+
+	for _, limits := range []query.CostLimits{
+		{MaxScore: 10},
+		{MaxClauses: 2},
+		{MaxDepth: 2},
+		{MaxOneOfValues: 2},
+	} {
+		err := report.Check(limits)
+		require.ErrorIs(t, err, query.ErrTooExpensive, "%+v", limits)
+	}
+}
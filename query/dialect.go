@@ -0,0 +1,93 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Dialect defines the SQL flavor rendered by SQLRenderer.
+type Dialect uint8
+
+const (
+	Postgres  Dialect = iota + 1 // `$1` placeholders, "quoted" identifiers, TRUE and FALSE
+	MySQL                        // `?` placeholders, `quoted` identifiers, TRUE and FALSE
+	SQLite                       // `?` placeholders, "quoted" identifiers, 1 and 0
+	SQLServer                    // `@p1` placeholders, [quoted] identifiers, 1 and 0
+)
+
+func (d Dialect) String() string {
+	switch d {
+	case Postgres:
+		return "postgres"
+	case MySQL:
+		return "mysql"
+	case SQLite:
+		return "sqlite"
+	case SQLServer:
+		return "sqlserver"
+	default:
+		return "unknown!"
+	}
+}
+
+func (d Dialect) valid() bool {
+	return d >= Postgres && d <= SQLServer
+}
+
+// placeholder renders the placeholder of the n-th argument, starting with 1.
+func (d Dialect) placeholder(n int) string {
+	switch d { //nolint:exhaustive
+	case Postgres:
+		return "$" + strconv.Itoa(n)
+	case SQLServer:
+		return "@p" + strconv.Itoa(n)
+	default:
+		return "?"
+	}
+}
+
+// quote renders the name as a quoted identifier, so reserved words and special characters are safe to use.
+func (d Dialect) quote(name string) string {
+	switch d { //nolint:exhaustive
+	case MySQL:
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	case SQLServer:
+		return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+	default:
+		return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+	}
+}
+
+// boolean renders the boolean literal. SQLite and SQL Server have no boolean type and store booleans as 1 and 0.
+func (d Dialect) boolean(v bool) string {
+	switch {
+	case (d == SQLite || d == SQLServer) && v:
+		return "1"
+	case d == SQLite || d == SQLServer:
+		return "0"
+	case v:
+		return "TRUE"
+	default:
+		return "FALSE"
+	}
+}
+
+// likeEscape returns the escape character of LIKE patterns. MySQL interprets backslashes in string literals
+// depending on the NO_BACKSLASH_ESCAPES mode, so it uses `!`, which needs no escaping in any mode.
+func (d Dialect) likeEscape() byte {
+	if d == MySQL {
+		return '!'
+	}
+
+	return '\\'
+}
+
+// escapeLike escapes the wildcards of LIKE patterns in s, so it is matched literally. SQL Server
+// additionally treats `[` as the start of a character class.
+func (d Dialect) escapeLike(s string) string {
+	if d == SQLServer {
+		return escapeLike(s, d.likeEscape(), "[")
+	}
+
+	return escapeLike(s, d.likeEscape(), "")
+}
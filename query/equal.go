@@ -0,0 +1,116 @@
+package query
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// EqualOption configures Equals and Hash.
+type EqualOption func(*comparer)
+
+// IgnoreOperandOrder makes Equals and Hash treat `and` and `or` as commutative and associative,
+// so `a and (b and c)` equals `c and b and a`.
+func IgnoreOperandOrder() EqualOption {
+	return func(c *comparer) { c.operandOrder = false }
+}
+
+// IgnoreOneOfOrder makes Equals and Hash treat one-of lists as unordered, so `a:[1, 2]` equals `a:[2, 1]`.
+func IgnoreOneOfOrder() EqualOption {
+	return func(c *comparer) { c.oneOfOrder = false }
+}
+
+// Equals reports whether two expressions are structurally equal. Since the AST doesn't keep the spelling
+// of the query, queries which only differ in whitespace, quoting, `:` vs `=` or `and` vs `AND` are equal.
+// Equals is named so because Equal is the equality operator.
+//
+// By default the order of operands and one-of values matters, see IgnoreOperandOrder and IgnoreOneOfOrder.
+// Duplicates are never ignored, so `a and a` doesn't equal `a`; use Simplify to remove them first.
+func Equals(a, b Expr, opts ...EqualOption) bool {
+	c := newComparer(opts)
+	return c.key(a) == c.key(b)
+}
+
+// Hash returns a hash of the expression which is stable across processes and releases, so it can be
+// used as a cache key. Expressions which are equal according to Equals with the same options have
+// equal hashes.
+func Hash(expr Expr, opts ...EqualOption) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(newComparer(opts).key(expr)))
+
+	return h.Sum64()
+}
+
+type comparer struct {
+	operandOrder bool
+	oneOfOrder   bool
+}
+
+func newComparer(opts []EqualOption) *comparer {
+	c := &comparer{operandOrder: true, oneOfOrder: true}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// key returns the canonical representation of the expression. Equal expressions have equal keys.
+func (c *comparer) key(expr Expr) string {
+	if isNil(expr) {
+		return "nil"
+	}
+
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		if c.operandOrder {
+			return fmt.Sprintf("(%d %s %s)", e.Op, c.key(e.Left), c.key(e.Right))
+		}
+
+		operands := c.operands(e.Op, e, nil)
+		slices.Sort(operands)
+
+		return fmt.Sprintf("(%d %s)", e.Op, strings.Join(operands, " "))
+	case *NotExpr:
+		return "(not " + c.key(e.Expr) + ")"
+	case *FieldExpr:
+		field := e.Field
+		if canonical, err := canonicalIdentifier(string(field)); err == nil {
+			field = canonical
+		}
+
+		return fmt.Sprintf("(field %s %d %s)", strconv.Quote(string(field)), e.Op, c.valueKey(e.Value))
+	default:
+		return fmt.Sprintf("(%T %s)", expr, strconv.Quote(expr.String()))
+	}
+}
+
+// operands appends the keys of the operands of the chain of op to keys.
+func (c *comparer) operands(op BooleanOperator, expr Expr, keys []string) []string {
+	if b, ok := expr.(*BinaryExpr); ok && b != nil && b.Op == op {
+		keys = c.operands(op, b.Left, keys)
+		return c.operands(op, b.Right, keys)
+	}
+
+	return append(keys, c.key(expr))
+}
+
+func (c *comparer) valueKey(v Valuer) string {
+	oneOf, ok := v.(*OneOfExpr)
+	if !ok || oneOf == nil {
+		return valueKey(v)
+	}
+
+	keys := make([]string, 0, len(oneOf.Values))
+	for _, v := range oneOf.Values {
+		keys = append(keys, c.valueKey(v))
+	}
+
+	if !c.oneOfOrder {
+		slices.Sort(keys)
+	}
+
+	return "[" + strings.Join(keys, ",") + "]"
+}
@@ -0,0 +1,144 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.tomakado.io/dumbql/query"
+)
+
+func TestEquals(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		name string
+		a, b string
+		opts []query.EqualOption
+		want bool
+	}{
+		{
+			name: "spelling",
+			a:    `status:pending AND  age>=18.0 and x EXISTS`,
+			b:    "(`status` = \"pending\") and age >= 18 and x?",
+			want: true,
+		},
+		{
+			name: "boolean shorthand",
+			a:    `verified`,
+			b:    `verified:true`,
+			want: true,
+		},
+		{
+			name: "negative zero",
+			a:    `a:-0`,
+			b:    `a:0`,
+			want: true,
+		},
+		{
+			name: "different operator",
+			a:    `a:1`,
+			b:    `a!=1`,
+			want: false,
+		},
+		{
+			name: "different value type",
+			a:    `a:"1"`,
+			b:    `a:1`,
+			want: false,
+		},
+		{
+			name: "different field",
+			a:    `a.b:1`,
+			b:    `a["b"]:1`,
+			want: false,
+		},
+		{
+			name: "operand order matters by default",
+			a:    `a:1 and b:2`,
+			b:    `b:2 and a:1`,
+			want: false,
+		},
+		{
+			name: "grouping matters by default",
+			a:    `a:1 and (b:2 and c:3)`,
+			b:    `a:1 and b:2 and c:3`,
+			want: false,
+		},
+		{
+			name: "operand order ignored",
+			a:    `a:1 and (b:2 and c:3) and (d:4 or e:5)`,
+			b:    `(e:5 or d:4) and c:3 and b:2 and a:1`,
+			opts: []query.EqualOption{query.IgnoreOperandOrder()},
+			want: true,
+		},
+		{
+			name: "operators are not mixed up",
+			a:    `a:1 and b:2 or c:3`,
+			b:    `a:1 and (b:2 or c:3)`,
+			opts: []query.EqualOption{query.IgnoreOperandOrder()},
+			want: false,
+		},
+		{
+			name: "duplicates are kept",
+			a:    `a:1 and a:1`,
+			b:    `a:1`,
+			opts: []query.EqualOption{query.IgnoreOperandOrder()},
+			want: false,
+		},
+		{
+			name: "one-of order matters by default",
+			a:    `a:[1, 2]`,
+			b:    `a:[2, 1]`,
+			want: false,
+		},
+		{
+			name: "one-of order ignored",
+			a:    `a:[1, x, true]`,
+			b:    `a:[true, 1, x]`,
+			opts: []query.EqualOption{query.IgnoreOneOfOrder()},
+			want: true,
+		},
+		{
+			name: "one-of order ignored, values differ",
+			a:    `a:[1, 2]`,
+			b:    `a:[1, 2, 2]`,
+			opts: []query.EqualOption{query.IgnoreOneOfOrder()},
+			want: false,
+		},
+		{
+			name: "negation",
+			a:    `not (a:1 or b:2)`,
+			b:    `not (b:2 or a:1)`,
+			opts: []query.EqualOption{query.IgnoreOperandOrder(), query.IgnoreOneOfOrder()},
+			want: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, b := mustParse(t, test.a), mustParse(t, test.b)
+
+			assert.Equal(t, test.want, query.Equals(a, b, test.opts...))
+			assert.Equal(t, test.want, query.Equals(b, a, test.opts...))
+			assert.Equal(t, test.want, query.Hash(a, test.opts...) == query.Hash(b, test.opts...))
+		})
+	}
+}
+
+func TestEquals_Programmatic(t *testing.T) {
+	assert.True(t, query.Equals(nil, nil))
+	assert.False(t, query.Equals(nil, mustParse(t, `a:1`)))
+	assert.True(t, query.Equals(
+		&query.FieldExpr{Field: "`a`.b", Op: query.Equal, Value: &query.NumberLiteral{NumberValue: 1}},
+		mustParse(t, `a.b:1`),
+	))
+	assert.False(t, query.Equals(
+		&query.FieldExpr{Field: "a", Op: query.Equal, Value: query.Identifier("x")},
+		mustParse(t, `a:x`),
+	))
+}
+
+func TestHash_Stable(t *testing.T) {
+	// The hash is used as a persistent cache key and must not change between releases.
+	expr := mustParse(t, `status:pending and (age>=18 or not tags:[a, "b"])`)
+
+	assert.Equal(t, uint64(0x6787f5fc3f965b71), query.Hash(expr))
+}
@@ -0,0 +1,77 @@
+package query
+
+import "slices"
+
+// FieldRef describes a single field expression of a query.
+type FieldRef struct {
+	Path    Identifier    // Canonical field path, use Path.Segments to inspect it
+	Op      FieldOperator // Operator of the expression
+	Values  []any         // Literal values: a single value, or every value of a one-of list
+	Negated bool          // Whether the expression sits under an odd number of `not`
+	Expr    *FieldExpr    // The expression itself
+}
+
+// Fields returns a summary of every field expression of the query in source order. It can serve as a base
+// for auditing, index selection or access checks without walking the AST.
+func Fields(expr Expr) []FieldRef {
+	var refs []FieldRef
+	collectFields(expr, false, &refs)
+
+	return refs
+}
+
+// FieldPaths returns the distinct canonical paths of the fields referenced by the query, sorted.
+func FieldPaths(expr Expr) []Identifier {
+	var paths []Identifier
+	for _, ref := range Fields(expr) {
+		paths = append(paths, ref.Path)
+	}
+
+	slices.Sort(paths)
+
+	return slices.Compact(paths)
+}
+
+func collectFields(expr Expr, negated bool, refs *[]FieldRef) {
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		if e != nil {
+			collectFields(e.Left, negated, refs)
+			collectFields(e.Right, negated, refs)
+		}
+	case *NotExpr:
+		if e != nil {
+			collectFields(e.Expr, !negated, refs)
+		}
+	case *FieldExpr:
+		if e != nil {
+			*refs = append(*refs, newFieldRef(e, negated))
+		}
+	}
+}
+
+func newFieldRef(f *FieldExpr, negated bool) FieldRef {
+	path := f.Field
+	if canonical, err := canonicalIdentifier(string(path)); err == nil {
+		path = canonical
+	}
+
+	ref := FieldRef{Path: path, Op: f.Op, Negated: negated, Expr: f}
+
+	switch v := f.Value.(type) {
+	case *OneOfExpr:
+		if v != nil {
+			for _, val := range v.Values {
+				if !isNil(val) {
+					ref.Values = append(ref.Values, val.Value())
+				}
+			}
+		}
+	default:
+		if !isNil(v) {
+			ref.Values = []any{v.Value()}
+		}
+	}
+
+	return ref
+}
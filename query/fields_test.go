@@ -0,0 +1,56 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+)
+
+func TestFields(t *testing.T) {
+	expr := mustParse(t, "status:[new, 1, true] and not (age >= 18 or not `profile`.city~BCN) and email? and tags[0]:x")
+
+	refs := query.Fields(expr)
+	require.Len(t, refs, 5)
+
+	type ref struct {
+		Path    query.Identifier
+		Op      query.FieldOperator
+		Values  []any
+		Negated bool
+	}
+
+	var got []ref
+	for _, r := range refs {
+		got = append(got, ref{r.Path, r.Op, r.Values, r.Negated})
+	}
+
+	assert.Equal(t, []ref{
+		{Path: "status", Op: query.Equal, Values: []any{"new", 1.0, true}},
+		{Path: "age", Op: query.GreaterThanOrEqual, Values: []any{18.0}, Negated: true},
+		{Path: "profile.city", Op: query.Like, Values: []any{"BCN"}},
+		{Path: "email", Op: query.Exists, Values: []any{true}},
+		{Path: "tags[0]", Op: query.Equal, Values: []any{"x"}},
+	}, got)
+
+	assert.Same(t, expr.(*query.BinaryExpr).Right, refs[4].Expr)
+}
+
+func TestFields_Programmatic(t *testing.T) {
+	refs := query.Fields(&query.NotExpr{Expr: &query.FieldExpr{Field: "`a`", Op: query.Equal, Value: &query.OneOfExpr{}}})
+
+	require.Len(t, refs, 1)
+	assert.Equal(t, query.Identifier("a"), refs[0].Path)
+	assert.Empty(t, refs[0].Values)
+	assert.True(t, refs[0].Negated)
+
+	assert.Empty(t, query.Fields(nil))
+	assert.Empty(t, query.Fields(&query.BinaryExpr{}))
+}
+
+func TestFieldPaths(t *testing.T) {
+	expr := mustParse(t, `b:1 or (a:2 and b:3) or c? or a.x:4`)
+
+	assert.Equal(t, []query.Identifier{"a", "a.x", "b", "c"}, query.FieldPaths(expr))
+}
@@ -0,0 +1,331 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FormatOption configures the output of Format.
+type FormatOption func(*formatter)
+
+// WithColonEquality makes Format use `:` and `!:` instead of `=` and `!=`, written without spaces around them.
+func WithColonEquality() FormatOption {
+	return func(f *formatter) { f.colon = true }
+}
+
+// WithUppercaseKeywords makes Format use `AND`, `OR`, `NOT` and `EXISTS` instead of their lowercase forms.
+func WithUppercaseKeywords() FormatOption {
+	return func(f *formatter) { f.upper = true }
+}
+
+// WithExistsKeyword makes Format render presence checks as `field exists` instead of `field?`.
+func WithExistsKeyword() FormatOption {
+	return func(f *formatter) { f.existsKeyword = true }
+}
+
+// WithBareStrings makes Format omit quotes around string values which can be written as bare words.
+func WithBareStrings() FormatOption {
+	return func(f *formatter) { f.bareStrings = true }
+}
+
+// WithBoolShorthand makes Format render `field = true` as just `field`.
+func WithBoolShorthand() FormatOption {
+	return func(f *formatter) { f.boolShorthand = true }
+}
+
+// Format renders the expression back to DumbQL syntax, so the result can be shown to users or stored
+// instead of the original query. Parentheses are only emitted where they are required to keep the
+// structure of the tree, so parsing the result yields an expression equal to expr.
+//
+// Format returns an error if the expression can't be represented in DumbQL, e.g. it contains nil nodes,
+// unknown operators, nested one-of lists or non-finite numbers.
+func Format(expr Expr, opts ...FormatOption) (string, error) {
+	f := &formatter{}
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	if err := f.expr(expr); err != nil {
+		return "", err
+	}
+
+	return f.b.String(), nil
+}
+
+type formatter struct {
+	b strings.Builder
+
+	colon         bool
+	upper         bool
+	existsKeyword bool
+	bareStrings   bool
+	boolShorthand bool
+}
+
+func (f *formatter) keyword(kw string) {
+	if f.upper {
+		kw = strings.ToUpper(kw)
+	}
+	f.b.WriteString(kw)
+}
+
+func (f *formatter) expr(expr Expr) error {
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		return f.binary(e)
+	case *NotExpr:
+		return f.not(e)
+	case *FieldExpr:
+		return f.field(e)
+	case nil:
+		return errors.New("format: nil expression")
+	default:
+		return fmt.Errorf("format: unsupported expression %T", expr)
+	}
+}
+
+// binary renders a boolean expression. The grammar parses `and` with higher precedence than `or`
+// and both operators as left-associative, so only a right operand with the same operator
+// or an `or` operand of `and` has to be parenthesized.
+func (f *formatter) binary(e *BinaryExpr) error {
+	if e == nil {
+		return errors.New("format: nil expression")
+	}
+
+	var op string
+
+	switch e.Op {
+	case And:
+		op = "and"
+	case Or:
+		op = "or"
+	default:
+		return fmt.Errorf("format: unknown operator %q", e.Op)
+	}
+
+	if err := f.operand(e.Left, e.Op, false); err != nil {
+		return err
+	}
+
+	f.b.WriteByte(' ')
+	f.keyword(op)
+	f.b.WriteByte(' ')
+
+	return f.operand(e.Right, e.Op, true)
+}
+
+func (f *formatter) operand(expr Expr, parent BooleanOperator, right bool) error {
+	if !needsParens(expr, parent, right) {
+		return f.expr(expr)
+	}
+
+	return f.paren(expr)
+}
+
+func needsParens(expr Expr, parent BooleanOperator, right bool) bool {
+	b, ok := expr.(*BinaryExpr)
+	if !ok || b == nil {
+		return false
+	}
+
+	return (parent == And && b.Op == Or) || (right && b.Op == parent)
+}
+
+func (f *formatter) paren(expr Expr) error {
+	f.b.WriteByte('(')
+	if err := f.expr(expr); err != nil {
+		return err
+	}
+	f.b.WriteByte(')')
+
+	return nil
+}
+
+// not renders a negation. The grammar only allows a field expression or a parenthesized
+// expression after `not`.
+func (f *formatter) not(e *NotExpr) error {
+	if e == nil {
+		return errors.New("format: nil expression")
+	}
+
+	f.keyword("not")
+	f.b.WriteByte(' ')
+
+	if field, ok := e.Expr.(*FieldExpr); ok && field != nil {
+		return f.field(field)
+	}
+
+	return f.paren(e.Expr)
+}
+
+func (f *formatter) field(e *FieldExpr) error {
+	if e == nil {
+		return errors.New("format: nil expression")
+	}
+
+	if err := f.fieldName(e.Field); err != nil {
+		return err
+	}
+
+	if e.Op == Exists {
+		if f.existsKeyword {
+			f.b.WriteByte(' ')
+			f.keyword("exists")
+		} else {
+			f.b.WriteByte('?')
+		}
+
+		return nil
+	}
+
+	if b, ok := e.Value.(*BoolLiteral); ok && b != nil && b.BoolValue && e.Op == Equal && f.boolShorthand {
+		return nil
+	}
+
+	op, err := f.fieldOperator(e.Op)
+	if err != nil {
+		return err
+	}
+
+	// Colon operators are conventionally written without spaces, e.g. `status:pending`.
+	if f.colon && (e.Op == Equal || e.Op == NotEqual) {
+		f.b.WriteString(op)
+	} else {
+		f.b.WriteString(" " + op + " ")
+	}
+
+	if oneOf, ok := e.Value.(*OneOfExpr); ok && oneOf != nil {
+		return f.oneOf(oneOf)
+	}
+
+	return f.value(e.Value)
+}
+
+func (f *formatter) fieldName(field Identifier) error {
+	canonical, err := canonicalIdentifier(string(field))
+	if err != nil {
+		return fmt.Errorf("format: %w", err)
+	}
+
+	// A bare field starting with `not` would be parsed as a negation, e.g. `notes` as `not es`.
+	if name := string(canonical); strings.HasPrefix(name, "not") || strings.HasPrefix(name, "NOT") {
+		first, _, _ := strings.Cut(name, ".")
+		first, _, _ = strings.Cut(first, "[")
+		f.b.WriteString("`" + first + "`")
+		f.b.WriteString(name[len(first):])
+
+		return nil
+	}
+
+	f.b.WriteString(string(canonical))
+
+	return nil
+}
+
+func (f *formatter) fieldOperator(op FieldOperator) (string, error) {
+	switch op {
+	case Equal:
+		if f.colon {
+			return ":", nil
+		}
+		return "=", nil
+	case NotEqual:
+		if f.colon {
+			return "!:", nil
+		}
+		return "!=", nil
+	case GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual, Like, Contains:
+		return op.String(), nil
+	case Exists:
+		return "", errors.New("format: exists operator has no value")
+	default:
+		return "", fmt.Errorf("format: unknown operator %q", op)
+	}
+}
+
+func (f *formatter) oneOf(o *OneOfExpr) error {
+	f.b.WriteByte('[')
+
+	for i, v := range o.Values {
+		if i > 0 {
+			f.b.WriteString(", ")
+		}
+
+		if err := f.value(v); err != nil {
+			return err
+		}
+	}
+
+	f.b.WriteByte(']')
+
+	return nil
+}
+
+func (f *formatter) value(v Valuer) error {
+	switch val := v.(type) {
+	case *StringLiteral:
+		if val == nil {
+			return errors.New("format: nil value")
+		}
+		f.str(val.StringValue)
+	case Identifier:
+		f.str(string(val))
+	case *NumberLiteral:
+		if val == nil {
+			return errors.New("format: nil value")
+		}
+		return f.number(val.NumberValue)
+	case *BoolLiteral:
+		if val == nil {
+			return errors.New("format: nil value")
+		}
+		f.b.WriteString(strconv.FormatBool(val.BoolValue))
+	case *OneOfExpr:
+		return errors.New("format: nested one-of expressions are not supported")
+	case nil:
+		return errors.New("format: nil value")
+	default:
+		return fmt.Errorf("format: unsupported value %T", v)
+	}
+
+	return nil
+}
+
+func (f *formatter) str(s string) {
+	if f.bareStrings && isBareValue(s) {
+		f.b.WriteString(s)
+		return
+	}
+
+	f.b.WriteString(quoteString(s))
+}
+
+// isBareValue reports whether s is parsed back as the same string when written without quotes,
+// i.e. it matches the Identifier rule of the grammar and doesn't start with a boolean literal.
+// The grammar tries booleans before identifiers without a word boundary, so `truex` is parsed as true.
+func isBareValue(s string) bool {
+	if strings.HasPrefix(s, "true") || strings.HasPrefix(s, "false") {
+		return false
+	}
+
+	for part := range strings.SplitSeq(s, ".") {
+		if !isBareName(part) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *formatter) number(n float64) error {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return fmt.Errorf("format: number %v can't be represented", n)
+	}
+
+	f.b.WriteString(strconv.FormatFloat(n, 'f', -1, 64))
+
+	return nil
+}
@@ -0,0 +1,271 @@
+package query_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+)
+
+func TestFormat(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		input string
+		opts  []query.FormatOption
+		want  string
+	}{
+		{
+			input: "status:200",
+			want:  "status = 200",
+		},
+		{
+			input: "eps<0.003",
+			want:  "eps < 0.003",
+		},
+		{
+			input: "a:1 and b:2 and c:3",
+			want:  "a = 1 and b = 2 and c = 3",
+		},
+		{
+			input: "a:1 and (b:2 and c:3)",
+			want:  "a = 1 and (b = 2 and c = 3)",
+		},
+		{
+			input: "a:1 or b:2 and c:3",
+			want:  "a = 1 or b = 2 and c = 3",
+		},
+		{
+			input: "(a:1 or b:2) and c:3",
+			want:  "(a = 1 or b = 2) and c = 3",
+		},
+		{
+			input: "((a:1))",
+			want:  "a = 1",
+		},
+		{
+			input: "not (a:1 or b:2)",
+			want:  "not (a = 1 or b = 2)",
+		},
+		{
+			input: "not a:1 and b:2",
+			want:  "not a = 1 and b = 2",
+		},
+		{
+			input: "not (not a:1)",
+			want:  "not (not a = 1)",
+		},
+		{
+			input: `name~"John" and city != "New York"`,
+			want:  `name ~ "John" and city != "New York"`,
+		},
+		{
+			input: `occupation: [designer, "ux analyst", 1, true]`,
+			want:  `occupation = ["designer", "ux analyst", 1, true]`,
+		},
+		{
+			input: "tags:[]",
+			want:  "tags = []",
+		},
+		{
+			input: "tags@>[urgent, bug]",
+			want:  `tags @> ["urgent", "bug"]`,
+		},
+		{
+			input: "name? and not email exists",
+			want:  "name? and not email?",
+		},
+		{
+			input: "verified and premium",
+			want:  "verified = true and premium = true",
+		},
+		{
+			input: `labels.` + "`app.kubernetes.io/name`" + `:web and items[-1].status:shipped and attributes["color"]:red`,
+			want: "labels.`app.kubernetes.io/name` = \"web\" and items[-1].status = \"shipped\"" +
+				" and attributes[\"color\"] = \"red\"",
+		},
+		{
+			input: "`notes`:1 and `nothing`[0]?",
+			want:  "`notes` = 1 and `nothing`[0]?",
+		},
+		{
+			input: `s:"line\nbreak \"quoted\" \\ tab\t"`,
+			want:  `s = "line\nbreak \"quoted\" \\ tab\t"`,
+		},
+		{
+			input: "n:-12.5 or n:100000000000000000000",
+			want:  "n = -12.5 or n = 100000000000000000000",
+		},
+		{
+			input: "a:x and b!=y or not c:true and d?",
+			opts: []query.FormatOption{
+				query.WithColonEquality(),
+				query.WithUppercaseKeywords(),
+				query.WithExistsKeyword(),
+				query.WithBareStrings(),
+			},
+			want: `a:x AND b!:y OR NOT c:true AND d EXISTS`,
+		},
+		{
+			input: `a:"true" and b:"x.y" and c:"x y" and d:[p, "1"]`,
+			opts:  []query.FormatOption{query.WithBareStrings()},
+			want:  `a = "true" and b = x.y and c = "x y" and d = [p, "1"]`,
+		},
+		{
+			input: `a:"truex" and b:"falsey" and c:[x, "true_1", "false.x"] and d:truth`,
+			opts:  []query.FormatOption{query.WithBareStrings()},
+			want:  `a = "truex" and b = "falsey" and c = [x, "true_1", "false.x"] and d = truth`,
+		},
+		{
+			input: "verified and not banned and level:true",
+			opts:  []query.FormatOption{query.WithBoolShorthand()},
+			want:  "verified and not banned and level",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			got, err := query.Format(ast.(query.Expr), test.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+
+			reparsed, err := query.Parse("test", []byte(got))
+			require.NoError(t, err)
+			assert.Equal(t, ast, reparsed)
+		})
+	}
+}
+
+func TestFormat_Programmatic(t *testing.T) {
+	// Right-nested chains built by hand keep their structure.
+	expr := &query.BinaryExpr{
+		Left: &query.FieldExpr{Field: "a", Op: query.Equal, Value: &query.NumberLiteral{NumberValue: 1}},
+		Op:   query.Or,
+		Right: &query.BinaryExpr{
+			Left:  &query.FieldExpr{Field: "b", Op: query.Equal, Value: query.Identifier("x")},
+			Op:    query.Or,
+			Right: &query.FieldExpr{Field: "c", Op: query.Exists},
+		},
+	}
+
+	got, err := query.Format(expr)
+	require.NoError(t, err)
+	assert.Equal(t, `a = 1 or (b = "x" or c?)`, got)
+}
+
+func TestFormat_Errors(t *testing.T) { //nolint:funlen
+	field := func(value query.Valuer) *query.FieldExpr {
+		return &query.FieldExpr{Field: "a", Op: query.Equal, Value: value}
+	}
+
+	tests := []struct {
+		name string
+		expr query.Expr
+	}{
+		{
+			name: "nil expression",
+			expr: nil,
+		},
+		{
+			name: "nil binary operand",
+			expr: &query.BinaryExpr{Left: field(&query.BoolLiteral{}), Op: query.And},
+		},
+		{
+			name: "unknown boolean operator",
+			expr: &query.BinaryExpr{Left: field(&query.BoolLiteral{}), Op: 255, Right: field(&query.BoolLiteral{})},
+		},
+		{
+			name: "nil negated expression",
+			expr: &query.NotExpr{},
+		},
+		{
+			name: "unknown field operator",
+			expr: &query.FieldExpr{Field: "a", Op: 255, Value: &query.BoolLiteral{}},
+		},
+		{
+			name: "invalid field",
+			expr: &query.FieldExpr{Field: "a..b", Op: query.Equal, Value: &query.BoolLiteral{}},
+		},
+		{
+			name: "nil value",
+			expr: field(nil),
+		},
+		{
+			name: "NaN",
+			expr: field(&query.NumberLiteral{NumberValue: math.NaN()}),
+		},
+		{
+			name: "infinity",
+			expr: field(&query.NumberLiteral{NumberValue: math.Inf(-1)}),
+		},
+		{
+			name: "nested one-of",
+			expr: field(&query.OneOfExpr{Values: []query.Valuer{&query.OneOfExpr{}}}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := query.Format(test.expr)
+			require.Error(t, err)
+		})
+	}
+}
+
+func FuzzFormat(f *testing.F) {
+	seeds := []string{
+		"status:200",
+		"a:1 and b:2 or c:3 and not (d:4 or e:5)",
+		"a:1 or (b:2 or c:3)",
+		"not (not x)",
+		`name~"John" and city != "New York"`,
+		`occupation: [designer, "ux analyst", 1.5, false]`,
+		"name? and not email exists",
+		"verified and premium or banned",
+		"labels.`app.kubernetes.io/name`:web and items[-1].status:shipped",
+		`attributes["color"] = red`,
+		`s:"é\n\t\"\\"`,
+		"n >= -0.000001 and n < 100000000000000000000",
+		"`notes`:1",
+		"x:true and y:false",
+		`a:"truex" and b:"falsey"`,
+		`c:[x, "true_1", "false.x"]`,
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	options := [][]query.FormatOption{
+		nil,
+		{
+			query.WithColonEquality(),
+			query.WithUppercaseKeywords(),
+			query.WithExistsKeyword(),
+			query.WithBareStrings(),
+			query.WithBoolShorthand(),
+		},
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		ast, err := query.Parse("fuzz", []byte(input))
+		if err != nil {
+			return
+		}
+
+		for _, opts := range options {
+			formatted, err := query.Format(ast.(query.Expr), opts...)
+			require.NoError(t, err)
+
+			reparsed, err := query.Parse("fuzz", []byte(formatted))
+			require.NoError(t, err, "formatted query: %s", formatted)
+			require.Equal(t, ast, reparsed, "formatted query: %s", formatted)
+
+			again, err := query.Format(reparsed.(query.Expr), opts...)
+			require.NoError(t, err)
+			require.Equal(t, formatted, again)
+		}
+	})
+}
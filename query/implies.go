@@ -0,0 +1,205 @@
+package query
+
+import (
+	"math"
+)
+
+// Implication is the result of Implies.
+type Implication uint8
+
+const (
+	Unknown Implication = iota // Implication could be neither proven nor disproven
+	Implied                    // Every record matching the first expression matches the second one
+)
+
+func (i Implication) String() string {
+	switch i {
+	case Unknown:
+		return "unknown"
+	case Implied:
+		return "implied"
+	default:
+		return "unknown!"
+	}
+}
+
+// Implies reports whether a implies b, i.e. whether every record matching a also matches b, e.g.
+// `age > 30 and city:BCN` implies `age > 18`. It can be used to reuse cached results of a broader query
+// or to check that a filter stays inside an allowed scope.
+//
+// Records are matched the way Expr.Match does it. Where SQL differs, i.e. missing fields match
+// comparisons in StructMatcher but never match them as NULL in SQL, only conclusions valid for both are drawn.
+//
+// Implies is sound but incomplete: Implied is only returned when the implication holds, while Unknown
+// means that it couldn't be proven, either because a doesn't imply b or because the reasoning below
+// isn't strong enough. Implies never claims that a doesn't imply b.
+//
+// The implication is checked clause by clause: a is converted to DNF and b to CNF, and every conjunction
+// of a must contain a field expression implying one of the field expressions of every disjunction of b.
+// Field expressions of the same field are compared by operator and value: equality and one-of are checked
+// value by value, numeric comparisons as intervals and `~` as substrings, or prefixes and suffixes in the
+// mode set by WithLikeMode. Negated field expressions only imply negations of field expressions they are
+// implied by. Presence checks and `!=` only imply themselves. Expressions with too many clauses in normal
+// form yield Unknown.
+//
+// Fields holding several values, see WithColumnTypes, match if any of their values matches, so
+// field expressions of them don't imply `!=`, e.g. `tags:a` doesn't imply `tags!=b`.
+func Implies(a, b Expr, opts ...AnalysisOption) Implication {
+	an := newAnalysis(opts, a, b)
+
+	dnf, err := Simplify(a, WithDNF())
+	if err != nil {
+		return Unknown
+	}
+
+	cnf, err := Simplify(b, WithCNF())
+	if err != nil {
+		return Unknown
+	}
+
+	for _, conj := range junction(dnf, Or) {
+		for _, disj := range junction(cnf, And) {
+			if !an.clauseImplies(junction(conj, And), junction(disj, Or)) {
+				return Unknown
+			}
+		}
+	}
+
+	return Implied
+}
+
+// junction returns the operands of the chain of op.
+func junction(expr Expr, op BooleanOperator) []Expr {
+	if b, ok := expr.(*BinaryExpr); ok && b != nil && b.Op == op {
+		return append(junction(b.Left, op), junction(b.Right, op)...)
+	}
+
+	return []Expr{expr}
+}
+
+// clauseImplies reports whether the conjunction of the literals conj implies the disjunction of the literals disj.
+func (a *analysis) clauseImplies(conj, disj []Expr) bool {
+	for _, l := range conj {
+		for _, m := range disj {
+			if a.literalImplies(l, m) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (a *analysis) literalImplies(l, m Expr) bool {
+	if Equals(l, m) {
+		return true
+	}
+
+	ln, lneg := l.(*NotExpr)
+	mn, mneg := m.(*NotExpr)
+
+	switch {
+	case lneg && mneg:
+		// Contraposition: `not x` implies `not y` if y implies x.
+		return a.literalImplies(mn.Expr, ln.Expr)
+	case lneg || mneg:
+		return false
+	}
+
+	lf, ok := l.(*FieldExpr)
+	if !ok {
+		return false
+	}
+
+	mf, ok := m.(*FieldExpr)
+	if !ok || !sameField(lf.Field, mf.Field) || isNil(lf.Value) || isNil(mf.Value) {
+		return false
+	}
+
+	if mf.Op == NotEqual && a.multiValued(lf.Field) {
+		// Some value of the field matching l says nothing about the other values.
+		return false
+	}
+
+	return a.fieldImplies(lf, mf)
+}
+
+func sameField(a, b Identifier) bool {
+	ca, errA := canonicalIdentifier(string(a))
+	cb, errB := canonicalIdentifier(string(b))
+
+	return errA == nil && errB == nil && ca == cb
+}
+
+// fieldImplies reports whether the field expression l implies m of the same field. Both expressions are
+// true for missing fields in StructMatcher and false in SQL, so only values of present fields matter,
+// unless one of them is a presence check.
+func (a *analysis) fieldImplies(l, m *FieldExpr) bool {
+	switch l.Op { //nolint:exhaustive
+	case Equal:
+		// The field holds one of the values of l, so m must match each of them.
+		for _, v := range equalityValues(l.Value) {
+			if isNil(v) || !a.matches(v.Value(), m.Value, m.Op) {
+				return false
+			}
+		}
+
+		return m.Op != Exists
+	case Like:
+		// The field contains, starts or ends with the value of l, so it contains, starts or ends with
+		// every substring, prefix or suffix of it.
+		s, ok := l.Value.Value().(string)
+		return ok && m.Op == Like && a.matches(s, m.Value, Like)
+	case GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual:
+		return rangeImplies(l, m)
+	default:
+		return false
+	}
+}
+
+func equalityValues(v Valuer) []Valuer {
+	if oneOf, ok := v.(*OneOfExpr); ok {
+		return oneOf.Values
+	}
+
+	return []Valuer{v}
+}
+
+// rangeImplies reports whether the numeric comparison l implies the numeric comparison or inequality m.
+func rangeImplies(l, m *FieldExpr) bool {
+	lv, ok := l.Value.(*NumberLiteral)
+	if !ok || math.IsNaN(lv.NumberValue) {
+		return false
+	}
+
+	mv, ok := m.Value.(*NumberLiteral)
+	if !ok || math.IsNaN(mv.NumberValue) {
+		return false
+	}
+
+	a, b := lv.NumberValue, mv.NumberValue
+	lower := l.Op == GreaterThan || l.Op == GreaterThanOrEqual
+	strict := l.Op == GreaterThan || l.Op == LessThan
+
+	switch m.Op { //nolint:exhaustive
+	case GreaterThan, GreaterThanOrEqual:
+		if !lower {
+			return false
+		}
+		// x > a implies x > b and x >= b if a >= b, x >= a implies x > b if a > b.
+		return a > b || (a == b && (strict || m.Op == GreaterThanOrEqual))
+	case LessThan, LessThanOrEqual:
+		if lower {
+			return false
+		}
+		return a < b || (a == b && (strict || m.Op == LessThanOrEqual))
+	case NotEqual:
+		// The excluded value must lie outside of the range of l.
+		if lower {
+			return b < a || (b == a && strict)
+		}
+		return b > a || (b == a && strict)
+	default:
+		return false
+	}
+}
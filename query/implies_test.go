@@ -0,0 +1,201 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.tomakado.io/dumbql/match"
+	"go.tomakado.io/dumbql/query"
+	"go.tomakado.io/dumbql/schema"
+)
+
+func TestImplies(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		a, b string
+		want query.Implication
+	}{
+		// Structure.
+		{a: `age > 30 and city:BCN`, b: `age > 18`, want: query.Implied},
+		{a: `a:1`, b: `a:1 or b:2`, want: query.Implied},
+		{a: `a:1 and b:2`, b: `b:2 and a:1`, want: query.Implied},
+		{a: `a:1 or b:2`, b: `a:1`, want: query.Unknown},
+		{a: `(a:1 or a:2) and b:3`, b: `a:[1, 2, 3] and b>=3`, want: query.Implied},
+		{a: `a:1 and (b:2 or c:3)`, b: `(a:1 and b:2) or (a:1 and c:3)`, want: query.Implied},
+		{a: `not (a:1 or b:2)`, b: `not a:1`, want: query.Implied},
+		{a: `not (not a:1)`, b: `a:1`, want: query.Implied},
+		// Equality and one-of.
+		{a: `age:35`, b: `age > 18`, want: query.Implied},
+		{a: `age:10`, b: `age > 18`, want: query.Unknown},
+		{a: `city:[BCN, MAD]`, b: `city:[MAD, BCN, LIS]`, want: query.Implied},
+		{a: `city:[BCN, MAD]`, b: `city:BCN`, want: query.Unknown},
+		{a: `city:BCN`, b: `city!=MAD`, want: query.Implied},
+		{a: `city:BCN`, b: `city~"C"`, want: query.Implied},
+		{a: `age:"35"`, b: `age > 18`, want: query.Unknown},
+		{a: `age:35`, b: `other > 18`, want: query.Unknown},
+		{a: `verified`, b: `verified != false`, want: query.Implied},
+		// Numeric ranges.
+		{a: `age > 30`, b: `age >= 30`, want: query.Implied},
+		{a: `age >= 30`, b: `age > 30`, want: query.Unknown},
+		{a: `age >= 31`, b: `age > 30`, want: query.Implied},
+		{a: `age < 10`, b: `age <= 10`, want: query.Implied},
+		{a: `age <= 10`, b: `age < 10`, want: query.Unknown},
+		{a: `age < 10`, b: `age > 5`, want: query.Unknown},
+		{a: `age > 30`, b: `age != 30`, want: query.Implied},
+		{a: `age >= 30`, b: `age != 30`, want: query.Unknown},
+		{a: `age <= 5`, b: `age != 7`, want: query.Implied},
+		{a: `age > 30`, b: `age:[40, 50]`, want: query.Unknown},
+		// Like.
+		{a: `name~john`, b: `name~oh`, want: query.Implied},
+		{a: `name~oh`, b: `name~john`, want: query.Unknown},
+		{a: `name~john`, b: `name:john`, want: query.Unknown},
+		// Negations.
+		{a: `not age > 18`, b: `not age > 30`, want: query.Implied},
+		{a: `not age > 30`, b: `not age > 18`, want: query.Unknown},
+		{a: `not city:[BCN, MAD]`, b: `not city:BCN`, want: query.Implied},
+		{a: `not age <= 18`, b: `age > 18`, want: query.Unknown},
+		{a: `age > 18`, b: `not age <= 18`, want: query.Unknown},
+		{a: `city:BCN`, b: `not city:MAD`, want: query.Unknown},
+		// Presence.
+		{a: `email?`, b: `email exists`, want: query.Implied},
+		{a: `email:"a@b.c"`, b: `email?`, want: query.Unknown},
+		{a: `email?`, b: `email~"@"`, want: query.Unknown},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+" => "+test.b, func(t *testing.T) {
+			assert.Equal(t, test.want, query.Implies(mustParse(t, test.a), mustParse(t, test.b)))
+		})
+	}
+}
+
+func TestImplies_Sound(t *testing.T) {
+	type record struct {
+		Age  any    `dumbql:"age"`
+		City string `dumbql:"city"`
+	}
+
+	queries := []string{
+		`age > 30`, `age >= 30`, `age < 30`, `age:30`, `age != 30`, `not age > 30`, `age:[20, 30]`,
+		`city:BCN`, `city~B`, `city?`, `not city:BCN`, `age > 20 and city:BCN`, `age:30 or city:MAD`,
+	}
+	records := []*record{
+		{Age: 20}, {Age: 30}, {Age: 40.5}, {Age: "30"}, {Age: nil},
+		{Age: 30, City: "BCN"}, {Age: 20, City: "MAD"}, {City: "BCN"},
+	}
+	matcher := &match.StructMatcher{}
+
+	for _, a := range queries {
+		for _, b := range queries {
+			if query.Implies(mustParse(t, a), mustParse(t, b)) != query.Implied {
+				continue
+			}
+
+			for _, r := range records {
+				if mustParse(t, a).Match(r, matcher) {
+					assert.True(t, mustParse(t, b).Match(r, matcher), "%s => %s on %+v", a, b, r)
+				}
+			}
+		}
+	}
+}
+
+func TestImplies_ArrayFields(t *testing.T) {
+	type record struct {
+		Tags []string `dumbql:"tags"`
+		N    []int    `dumbql:"n"`
+	}
+
+	types := query.WithColumnTypes(schema.ColumnTypes{
+		"tags": {SQL: "text", Array: true},
+		"n":    {SQL: "bigint", Array: true},
+	})
+
+	tests := []struct {
+		a, b string
+		want query.Implication
+	}{
+		{a: `tags:a`, b: `tags != b`, want: query.Unknown},
+		{a: `tags:[a, b]`, b: `tags != c`, want: query.Unknown},
+		{a: `n > 5`, b: `n != 3`, want: query.Unknown},
+		{a: `tags:a`, b: `tags:[a, b]`, want: query.Implied},
+		{a: `n > 5`, b: `n >= 5`, want: query.Implied},
+		{a: `not tags:[a, b]`, b: `not tags:a`, want: query.Implied},
+	}
+
+	records := []*record{
+		{Tags: []string{"a", "b"}, N: []int{3, 6}},
+		{Tags: []string{"a"}, N: []int{6}},
+		{},
+	}
+	matcher := &match.StructMatcher{}
+
+	for _, test := range tests {
+		t.Run(test.a+" => "+test.b, func(t *testing.T) {
+			a, b := mustParse(t, test.a), mustParse(t, test.b)
+			assert.Equal(t, test.want, query.Implies(a, b, types))
+
+			if test.want == query.Implied {
+				for _, r := range records {
+					assert.True(t, !a.Match(r, matcher) || b.Match(r, matcher), "%+v", r)
+				}
+			}
+		})
+	}
+
+	assert.Equal(t, query.Implied, query.Implies(mustParse(t, `tags:a`), mustParse(t, `tags != b`)))
+	assert.Equal(t, query.Unknown, query.Implies(mustParse(t, `tags:a and tags @> c`), mustParse(t, `tags != b`)))
+}
+
+func TestImplies_LikeMode(t *testing.T) {
+	type record struct {
+		Name string `dumbql:"name"`
+	}
+
+	tests := []struct {
+		a, b string
+		mode query.LikeMode
+		want query.Implication
+	}{
+		{a: `name~john`, b: `name~oh`, mode: query.LikeContains, want: query.Implied},
+		{a: `name:john`, b: `name~oh`, mode: query.LikeContains, want: query.Implied},
+		{a: `name~john`, b: `name~oh`, mode: query.LikePrefix, want: query.Unknown},
+		{a: `name:john`, b: `name~oh`, mode: query.LikePrefix, want: query.Unknown},
+		{a: `name~john`, b: `name~jo`, mode: query.LikePrefix, want: query.Implied},
+		{a: `name:john`, b: `name~[x, jo]`, mode: query.LikePrefix, want: query.Implied},
+		{a: `name~john`, b: `name~jo`, mode: query.LikeSuffix, want: query.Unknown},
+		{a: `name:john`, b: `name~jo`, mode: query.LikeSuffix, want: query.Unknown},
+		{a: `name~john`, b: `name~hn`, mode: query.LikeSuffix, want: query.Implied},
+	}
+
+	records := []*record{{Name: "john"}, {Name: "johnny"}, {Name: "ojohn"}, {}}
+
+	for _, test := range tests {
+		t.Run(test.mode.String()+": "+test.a+" => "+test.b, func(t *testing.T) {
+			a, b := mustParse(t, test.a), mustParse(t, test.b)
+			assert.Equal(t, test.want, query.Implies(a, b, query.WithLikeMode(test.mode)))
+
+			matcher := &match.StructMatcher{Like: test.mode}
+			for _, r := range records {
+				if test.want == query.Implied && a.Match(r, matcher) {
+					assert.True(t, b.Match(r, matcher), "%+v", r)
+				}
+			}
+		})
+	}
+}
+
+func TestImplies_TooManyClauses(t *testing.T) {
+	var a query.Builder
+	for i := range 11 {
+		a = a.And(query.F("x").Eq(i).Or(query.F("y").Eq(i)))
+	}
+
+	assert.Equal(t, query.Unknown, query.Implies(a.Expr(), query.F("x").Eq(1).Or(query.F("y").Eq(2)).Expr()))
+	assert.Equal(t, query.Unknown, query.Implies(nil, a.Expr()))
+}
+
+func TestImplication_String(t *testing.T) {
+	assert.Equal(t, "unknown", query.Unknown.String())
+	assert.Equal(t, "implied", query.Implied.String())
+	assert.Equal(t, "unknown!", query.Implication(42).String())
+}
@@ -0,0 +1,491 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// JSONVersion is the version of the JSON encoding written by MarshalJSON. UnmarshalJSON rejects envelopes
+// of other versions.
+const JSONVersion = 1
+
+// Node kinds of the JSON encoding.
+const (
+	kindBinary     = "binary"
+	kindNot        = "not"
+	kindField      = "field"
+	kindString     = "string"
+	kindNumber     = "number"
+	kindBool       = "bool"
+	kindIdentifier = "identifier"
+	kindOneOf      = "one_of"
+)
+
+type jsonEnvelope struct {
+	Version int             `json:"version"`
+	Expr    json.RawMessage `json:"expr"`
+}
+
+// MarshalJSON encodes the expression into a versioned envelope:
+//
+//	{"version": 1, "expr": {"kind": "field", "field": "status", "op": "=", "value": {"kind": "string", "value": "new"}}}
+//
+// Every node is encoded as an object with a "kind" tag: "binary" with "op", "left" and "right";
+// "not" with "expr"; "field" with "field", "op" and "value"; "string", "number", "bool" and "identifier"
+// with "value"; and "one_of" with "values". Boolean and field operators are encoded the same way String
+// renders them, e.g. "and" or ">=".
+func MarshalJSON(expr Expr) ([]byte, error) {
+	raw, err := marshalExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(jsonEnvelope{Version: JSONVersion, Expr: raw})
+}
+
+// UnmarshalJSON decodes an expression encoded with MarshalJSON. It rejects unsupported versions,
+// unknown node kinds and operators, unknown or missing properties and malformed field paths.
+func UnmarshalJSON(data []byte) (Expr, error) {
+	var env struct {
+		Version *int            `json:"version"`
+		Expr    json.RawMessage `json:"expr"`
+	}
+
+	if err := decodeStrict(data, &env); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case env.Version == nil:
+		return nil, errors.New("json: missing version")
+	case *env.Version != JSONVersion:
+		return nil, fmt.Errorf("json: unsupported version %d", *env.Version)
+	}
+
+	return unmarshalExpr(env.Expr)
+}
+
+func (b *BinaryExpr) MarshalJSON() ([]byte, error) {
+	if b.Op != And && b.Op != Or {
+		return nil, fmt.Errorf("json: unknown boolean operator %q", b.Op)
+	}
+
+	left, err := marshalExpr(b.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := marshalExpr(b.Right)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Kind  string          `json:"kind"`
+		Op    string          `json:"op"`
+		Left  json.RawMessage `json:"left"`
+		Right json.RawMessage `json:"right"`
+	}{kindBinary, b.Op.String(), left, right})
+}
+
+func (b *BinaryExpr) UnmarshalJSON(data []byte) error {
+	var node struct {
+		Kind  string          `json:"kind"`
+		Op    string          `json:"op"`
+		Left  json.RawMessage `json:"left"`
+		Right json.RawMessage `json:"right"`
+	}
+
+	if err := decodeNode(data, kindBinary, &node); err != nil {
+		return err
+	}
+
+	op, err := parseBooleanOperator(node.Op)
+	if err != nil {
+		return err
+	}
+
+	left, err := unmarshalExpr(node.Left)
+	if err != nil {
+		return err
+	}
+
+	right, err := unmarshalExpr(node.Right)
+	if err != nil {
+		return err
+	}
+
+	*b = BinaryExpr{Left: left, Op: op, Right: right}
+
+	return nil
+}
+
+func (n *NotExpr) MarshalJSON() ([]byte, error) {
+	expr, err := marshalExpr(n.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Kind string          `json:"kind"`
+		Expr json.RawMessage `json:"expr"`
+	}{kindNot, expr})
+}
+
+func (n *NotExpr) UnmarshalJSON(data []byte) error {
+	var node struct {
+		Kind string          `json:"kind"`
+		Expr json.RawMessage `json:"expr"`
+	}
+
+	if err := decodeNode(data, kindNot, &node); err != nil {
+		return err
+	}
+
+	expr, err := unmarshalExpr(node.Expr)
+	if err != nil {
+		return err
+	}
+
+	*n = NotExpr{Expr: expr}
+
+	return nil
+}
+
+func (f *FieldExpr) MarshalJSON() ([]byte, error) {
+	op, ok := fieldOperators[f.Op]
+	if !ok {
+		return nil, fmt.Errorf("json: unknown field operator %q", f.Op)
+	}
+
+	value, err := marshalValue(f.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Kind  string          `json:"kind"`
+		Field string          `json:"field"`
+		Op    string          `json:"op"`
+		Value json.RawMessage `json:"value"`
+	}{kindField, string(f.Field), op, value})
+}
+
+func (f *FieldExpr) UnmarshalJSON(data []byte) error {
+	var node struct {
+		Kind  string          `json:"kind"`
+		Field string          `json:"field"`
+		Op    string          `json:"op"`
+		Value json.RawMessage `json:"value"`
+	}
+
+	if err := decodeNode(data, kindField, &node); err != nil {
+		return err
+	}
+
+	field, err := canonicalIdentifier(node.Field)
+	if err != nil {
+		return fmt.Errorf("json: %w", err)
+	}
+
+	op, err := parseFieldOperator(node.Op)
+	if err != nil {
+		return err
+	}
+
+	value, err := unmarshalValue(node.Value)
+	if err != nil {
+		return err
+	}
+
+	*f = FieldExpr{Field: field, Op: op, Value: value}
+
+	return nil
+}
+
+func (s *StringLiteral) MarshalJSON() ([]byte, error) {
+	return marshalLiteral(kindString, s.StringValue)
+}
+
+func (s *StringLiteral) UnmarshalJSON(data []byte) error {
+	return unmarshalLiteral(data, kindString, &s.StringValue)
+}
+
+func (n *NumberLiteral) MarshalJSON() ([]byte, error) {
+	return marshalLiteral(kindNumber, n.NumberValue)
+}
+
+func (n *NumberLiteral) UnmarshalJSON(data []byte) error {
+	return unmarshalLiteral(data, kindNumber, &n.NumberValue)
+}
+
+func (b *BoolLiteral) MarshalJSON() ([]byte, error) {
+	return marshalLiteral(kindBool, b.BoolValue)
+}
+
+func (b *BoolLiteral) UnmarshalJSON(data []byte) error {
+	return unmarshalLiteral(data, kindBool, &b.BoolValue)
+}
+
+func (o *OneOfExpr) MarshalJSON() ([]byte, error) {
+	values := make([]json.RawMessage, 0, len(o.Values))
+
+	for _, v := range o.Values {
+		if _, nested := v.(*OneOfExpr); nested {
+			return nil, errors.New("json: nested one-of expressions are not supported")
+		}
+
+		raw, err := marshalValue(v)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, raw)
+	}
+
+	return json.Marshal(struct {
+		Kind   string            `json:"kind"`
+		Values []json.RawMessage `json:"values"`
+	}{kindOneOf, values})
+}
+
+func (o *OneOfExpr) UnmarshalJSON(data []byte) error {
+	var node struct {
+		Kind   string            `json:"kind"`
+		Values []json.RawMessage `json:"values"`
+	}
+
+	if err := decodeNode(data, kindOneOf, &node); err != nil {
+		return err
+	}
+
+	if node.Values == nil {
+		return errors.New("json: one_of: missing values")
+	}
+
+	var values []Valuer // Nil for empty lists, the same way the parser builds them
+
+	for _, raw := range node.Values {
+		v, err := unmarshalValue(raw)
+		if err != nil {
+			return err
+		}
+
+		if _, nested := v.(*OneOfExpr); nested {
+			return errors.New("json: nested one-of expressions are not supported")
+		}
+
+		values = append(values, v)
+	}
+
+	*o = OneOfExpr{Values: values}
+
+	return nil
+}
+
+func marshalExpr(expr Expr) (json.RawMessage, error) {
+	if isNil(expr) {
+		return nil, errors.New("json: nil expression")
+	}
+
+	switch e := expr.(type) {
+	case *BinaryExpr, *NotExpr, *FieldExpr:
+		return json.Marshal(e)
+	default:
+		return nil, fmt.Errorf("json: unsupported expression %T", expr)
+	}
+}
+
+func marshalValue(v Valuer) (json.RawMessage, error) {
+	if isNil(v) {
+		return nil, errors.New("json: nil value")
+	}
+
+	switch val := v.(type) {
+	case Identifier:
+		// Identifier keeps the JSON encoding of a plain string, so it's encoded here instead of in a method.
+		return marshalLiteral(kindIdentifier, string(val))
+	case *StringLiteral, *NumberLiteral, *BoolLiteral, *OneOfExpr:
+		return json.Marshal(val)
+	default:
+		return nil, fmt.Errorf("json: unsupported value %T", v)
+	}
+}
+
+func marshalLiteral(kind string, value any) ([]byte, error) {
+	return json.Marshal(struct {
+		Kind  string `json:"kind"`
+		Value any    `json:"value"`
+	}{kind, value})
+}
+
+func unmarshalExpr(data json.RawMessage) (Expr, error) {
+	kind, err := nodeKind(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var expr interface {
+		Expr
+		json.Unmarshaler
+	}
+
+	switch kind {
+	case kindBinary:
+		expr = &BinaryExpr{}
+	case kindNot:
+		expr = &NotExpr{}
+	case kindField:
+		expr = &FieldExpr{}
+	default:
+		return nil, fmt.Errorf("json: unknown expression kind %q", kind)
+	}
+
+	if err := expr.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+
+	return expr, nil
+}
+
+func unmarshalValue(data json.RawMessage) (Valuer, error) {
+	kind, err := nodeKind(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface {
+		Valuer
+		json.Unmarshaler
+	}
+
+	switch kind {
+	case kindString:
+		value = &StringLiteral{}
+	case kindNumber:
+		value = &NumberLiteral{}
+	case kindBool:
+		value = &BoolLiteral{}
+	case kindIdentifier:
+		// Identifier has no JSON methods, so it keeps the encoding of a plain string.
+		var id string
+		if err := unmarshalLiteral(data, kindIdentifier, &id); err != nil {
+			return nil, err
+		}
+		return Identifier(id), nil
+	case kindOneOf:
+		value = &OneOfExpr{}
+	default:
+		return nil, fmt.Errorf("json: unknown value kind %q", kind)
+	}
+
+	if err := value.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func unmarshalLiteral[T any](data []byte, kind string, dst *T) error {
+	var node struct {
+		Kind  string `json:"kind"`
+		Value *T     `json:"value"`
+	}
+
+	if err := decodeNode(data, kind, &node); err != nil {
+		return err
+	}
+
+	if node.Value == nil {
+		return fmt.Errorf("json: %s: missing value", kind)
+	}
+
+	*dst = *node.Value
+
+	return nil
+}
+
+// nodeKind returns the kind tag of the encoded node.
+func nodeKind(data json.RawMessage) (string, error) {
+	if len(data) == 0 {
+		return "", errors.New("json: missing node")
+	}
+
+	var node struct {
+		Kind string `json:"kind"`
+	}
+
+	if err := json.Unmarshal(data, &node); err != nil {
+		return "", fmt.Errorf("json: %w", err)
+	}
+
+	if node.Kind == "" {
+		return "", errors.New("json: missing node kind")
+	}
+
+	return node.Kind, nil
+}
+
+// decodeNode strictly decodes the node into dst and checks that its kind tag equals kind.
+// Missing child nodes are reported by the caller when decoding them.
+func decodeNode(data []byte, kind string, dst any) error {
+	if err := decodeStrict(data, dst); err != nil {
+		return err
+	}
+
+	if got, _ := nodeKind(data); got != kind {
+		return fmt.Errorf("json: expected %q node, got %q", kind, got)
+	}
+
+	return nil
+}
+
+func decodeStrict(data []byte, dst any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(dst); err != nil {
+		return fmt.Errorf("json: %w", err)
+	}
+
+	if dec.More() {
+		return errors.New("json: unexpected data after node")
+	}
+
+	return nil
+}
+
+var fieldOperators = map[FieldOperator]string{
+	Equal:              Equal.String(),
+	NotEqual:           NotEqual.String(),
+	GreaterThan:        GreaterThan.String(),
+	GreaterThanOrEqual: GreaterThanOrEqual.String(),
+	LessThan:           LessThan.String(),
+	LessThanOrEqual:    LessThanOrEqual.String(),
+	Like:               Like.String(),
+	Exists:             Exists.String(),
+	Contains:           Contains.String(),
+}
+
+func parseFieldOperator(s string) (FieldOperator, error) {
+	for op, str := range fieldOperators {
+		if str == s {
+			return op, nil
+		}
+	}
+
+	return 0, fmt.Errorf("json: unknown field operator %q", s)
+}
+
+func parseBooleanOperator(s string) (BooleanOperator, error) {
+	switch s {
+	case "and":
+		return And, nil
+	case "or":
+		return Or, nil
+	default:
+		return 0, fmt.Errorf("json: unknown boolean operator %q", s)
+	}
+}
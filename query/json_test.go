@@ -0,0 +1,204 @@
+package query_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	expr := mustParse(t, `status:new and not (age>=18.5 or verified) and labels.`+"`app/name`"+`:[web, "api"] and x?`)
+
+	data, err := query.MarshalJSON(expr)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"version": 1,
+		"expr": {
+			"kind": "binary", "op": "and",
+			"left": {
+				"kind": "binary", "op": "and",
+				"left": {
+					"kind": "binary", "op": "and",
+					"left": {"kind": "field", "field": "status", "op": "=", "value": {"kind": "string", "value": "new"}},
+					"right": {
+						"kind": "not",
+						"expr": {
+							"kind": "binary", "op": "or",
+							"left": {"kind": "field", "field": "age", "op": ">=", "value": {"kind": "number", "value": 18.5}},
+							"right": {"kind": "field", "field": "verified", "op": "=", "value": {"kind": "bool", "value": true}}
+						}
+					}
+				},
+				"right": {
+					"kind": "field", "field": "labels.`+"`app/name`"+`", "op": "=",
+					"value": {"kind": "one_of", "values": [{"kind": "string", "value": "web"}, {"kind": "string", "value": "api"}]}
+				}
+			},
+			"right": {"kind": "field", "field": "x", "op": "exists", "value": {"kind": "bool", "value": true}}
+		}
+	}`, string(data))
+
+	got, err := query.UnmarshalJSON(data)
+	require.NoError(t, err)
+	assert.Equal(t, expr, got)
+}
+
+func TestMarshalJSON_RoundTrip(t *testing.T) {
+	queries := []string{
+		`a:1`,
+		`a!=-0.25 or b<3 or c<=4 or d>5`,
+		`name~"jo\"hn" and tags:[] and items[0].sku:X and attributes["color"]:red`,
+		`not not enabled:false`,
+		`v:[1, true, "x"]`,
+		`tags @> [a, b] and not tags @> c`,
+		`ok and (a:1 or b:2) and not c exists`,
+	}
+
+	for _, q := range queries {
+		t.Run(q, func(t *testing.T) {
+			expr := mustParse(t, q)
+
+			data, err := query.MarshalJSON(expr)
+			require.NoError(t, err)
+
+			got, err := query.UnmarshalJSON(data)
+			require.NoError(t, err)
+			assert.Equal(t, expr, got)
+		})
+	}
+}
+
+func TestMarshalJSON_Node(t *testing.T) {
+	data, err := json.Marshal(&query.FieldExpr{Field: "a", Op: query.Like, Value: &query.StringLiteral{StringValue: "x"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kind": "field", "field": "a", "op": "~", "value": {"kind": "string", "value": "x"}}`, string(data))
+
+	var field query.FieldExpr
+	require.NoError(t, json.Unmarshal(data, &field))
+	assert.Equal(t, query.Identifier("a"), field.Field)
+
+	var not query.NotExpr
+	require.Error(t, json.Unmarshal(data, &not), "kind mismatch")
+}
+
+func TestMarshalJSON_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr query.Expr
+	}{
+		{name: "nil", expr: nil},
+		{name: "nil operand", expr: &query.NotExpr{}},
+		{name: "nil value", expr: &query.FieldExpr{Field: "a", Op: query.Equal}},
+		{name: "unknown operator", expr: &query.FieldExpr{Field: "a", Op: 42, Value: &query.BoolLiteral{}}},
+		{
+			name: "nested one-of",
+			expr: &query.FieldExpr{
+				Field: "a",
+				Op:    query.Equal,
+				Value: &query.OneOfExpr{Values: []query.Valuer{&query.OneOfExpr{}}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := query.MarshalJSON(test.expr)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestUnmarshalJSON_Errors(t *testing.T) { //nolint:funlen
+	const (
+		v1    = `{"version": 1, "expr": `
+		field = `{"kind": "field", "field": "a", "op": "=", "value": {"kind": "number", "value": 1}}`
+	)
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "invalid json", input: `{`},
+		{name: "missing version", input: `{"expr": ` + field + `}`},
+		{name: "unsupported version", input: `{"version": 2, "expr": ` + field + `}`},
+		{name: "missing expr", input: `{"version": 1}`},
+		{name: "null expr", input: `{"version": 1, "expr": null}`},
+		{name: "unknown envelope property", input: `{"version": 1, "expr": ` + field + `, "extra": 1}`},
+		{name: "trailing data", input: `{"version": 1, "expr": ` + field + `} {}`},
+		{name: "unknown expression kind", input: `{"version": 1, "expr": {"kind": "xor"}}`},
+		{name: "value as expression", input: `{"version": 1, "expr": {"kind": "string", "value": "x"}}`},
+		{name: "unknown property", input: v1 + `{"kind": "not", "expr": ` + field + `, "op": "x"}}`},
+		{
+			name:  "unknown boolean operator",
+			input: v1 + `{"kind": "binary", "op": "xor", "left": ` + field + `, "right": ` + field + `}}`,
+		},
+		{name: "missing operand", input: v1 + `{"kind": "binary", "op": "and", "left": ` + field + `}}`},
+		{name: "missing negated expression", input: `{"version": 1, "expr": {"kind": "not"}}`},
+		{
+			name:  "unknown field operator",
+			input: v1 + `{"kind": "field", "field": "a", "op": "<>", "value": {"kind": "bool", "value": true}}}`,
+		},
+		{
+			name:  "malformed field path",
+			input: v1 + `{"kind": "field", "field": "a..b", "op": "=", "value": {"kind": "bool", "value": true}}}`,
+		},
+		{name: "missing field value", input: `{"version": 1, "expr": {"kind": "field", "field": "a", "op": "="}}`},
+		{
+			name:  "unknown value kind",
+			input: v1 + `{"kind": "field", "field": "a", "op": "=", "value": {"kind": "date", "value": 1}}}`,
+		},
+		{
+			name:  "missing literal value",
+			input: `{"version": 1, "expr": {"kind": "field", "field": "a", "op": "=", "value": {"kind": "number"}}}`,
+		},
+		{
+			name:  "mistyped literal value",
+			input: v1 + `{"kind": "field", "field": "a", "op": "=", "value": {"kind": "number", "value": "1"}}}`,
+		},
+		{
+			name: "nested one-of",
+			input: `{"version": 1, "expr": {"kind": "field", "field": "a", "op": "=", "value": ` +
+				`{"kind": "one_of", "values": [{"kind": "one_of", "values": []}]}}}`,
+		},
+		{
+			name:  "missing one-of values",
+			input: `{"version": 1, "expr": {"kind": "field", "field": "a", "op": "=", "value": {"kind": "one_of"}}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := query.UnmarshalJSON([]byte(test.input))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMarshalJSON_Identifier(t *testing.T) {
+	expr := &query.FieldExpr{Field: "a", Op: query.Equal, Value: query.Identifier("b")}
+
+	data, err := query.MarshalJSON(expr)
+	require.NoError(t, err)
+
+	got, err := query.UnmarshalJSON(data)
+	require.NoError(t, err)
+	assert.Equal(t, expr, got)
+}
+
+func TestMarshalJSON_IdentifierField(t *testing.T) {
+	type filter struct {
+		Field query.Identifier `json:"field"`
+	}
+
+	data, err := json.Marshal(filter{Field: "user.name"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"field": "user.name"}`, string(data))
+
+	var got filter
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, query.Identifier("user.name"), got.Field)
+}
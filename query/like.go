@@ -0,0 +1,96 @@
+package query
+
+import (
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// LikeMode defines which part of a string value the `~` operator matches.
+type LikeMode uint8
+
+const (
+	LikeContains LikeMode = iota // Value contains the string, the default
+	LikePrefix                   // Value starts with the string
+	LikeSuffix                   // Value ends with the string
+)
+
+func (m LikeMode) String() string {
+	switch m {
+	case LikeContains:
+		return "contains"
+	case LikePrefix:
+		return "prefix"
+	case LikeSuffix:
+		return "suffix"
+	default:
+		return "unknown!"
+	}
+}
+
+// Match reports whether s matches the string of a `~` expression in this mode.
+func (m LikeMode) Match(s, substr string) bool {
+	switch m {
+	case LikePrefix:
+		return strings.HasPrefix(s, substr)
+	case LikeSuffix:
+		return strings.HasSuffix(s, substr)
+	default:
+		return strings.Contains(s, substr)
+	}
+}
+
+// pattern renders the LIKE pattern matching the escaped string in this mode.
+func (m LikeMode) pattern(escaped string) string {
+	switch m {
+	case LikePrefix:
+		return escaped + "%"
+	case LikeSuffix:
+		return "%" + escaped
+	default:
+		return "%" + escaped + "%"
+	}
+}
+
+// likeEscape is the escape character of LIKE patterns rendered by Expr.ToSql.
+const likeEscape = '\\'
+
+// Sqlizer wraps expr for squirrel builders, rendering it the way Expr.ToSql does with `~` matching in
+// this mode, e.g. with LikePrefix `name~jo` becomes `name LIKE ? ESCAPE '\'` with `jo%` bound.
+func (m LikeMode) Sqlizer(expr Expr) sq.Sqlizer {
+	return likeModeSqlizer{expr: expr, mode: m}
+}
+
+type likeModeSqlizer struct {
+	expr Expr
+	mode LikeMode
+}
+
+func (s likeModeSqlizer) ToSql() (string, []any, error) { //nolint:revive
+	switch e := s.expr.(type) {
+	case *BinaryExpr:
+		return e.toSql(s.mode)
+	case *NotExpr:
+		return e.toSql(s.mode)
+	case *FieldExpr:
+		return e.toSql(s.mode)
+	default:
+		return s.expr.ToSql()
+	}
+}
+
+// escapeLike escapes the LIKE wildcards `%` and `_`, the escape character and the additional special
+// characters in s, so it is matched literally.
+func escapeLike(s string, esc byte, special string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for i := range len(s) {
+		if ch := s[i]; ch == '%' || ch == '_' || ch == esc || strings.IndexByte(special, ch) >= 0 {
+			b.WriteByte(esc)
+		}
+		b.WriteByte(s[i])
+	}
+
+	return b.String()
+}
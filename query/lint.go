@@ -0,0 +1,322 @@
+package query
+
+import (
+	"fmt"
+	"math"
+)
+
+// WarningKind defines the kind of a Lint warning.
+type WarningKind uint8
+
+const (
+	Contradiction WarningKind = iota + 1 // Subexpression never matches, e.g. `age > 30 and age < 20`
+	Tautology                            // Subexpression always matches, e.g. `age > 20 or age < 30`
+)
+
+func (k WarningKind) String() string {
+	switch k {
+	case Contradiction:
+		return "contradiction"
+	case Tautology:
+		return "tautology"
+	default:
+		return "unknown!"
+	}
+}
+
+// Warning describes a suspicious subexpression found by Lint.
+type Warning struct {
+	Kind  WarningKind
+	Expr  Expr   // The `and` or `or` chain which never or always matches
+	Nodes []Expr // Operands of the chain which exclude or complement each other
+}
+
+func (w Warning) Error() string {
+	switch w.Kind {
+	case Contradiction:
+		return fmt.Sprintf("%s never matches: %v exclude each other", w.Expr, w.Nodes)
+	case Tautology:
+		return fmt.Sprintf("%s always matches: %v cover all values", w.Expr, w.Nodes)
+	default:
+		return fmt.Sprintf("%s: unknown warning", w.Expr)
+	}
+}
+
+// Lint looks for subexpressions which never match or always match and reports them as warnings.
+// It is meant to explain empty or unfiltered results to users and doesn't change the expression.
+//
+// Operands of every `and` chain are checked for contradictions and operands of every `or` chain for
+// tautologies, using interval reasoning over numeric comparisons and set reasoning over equality, one-of
+// and their negations, e.g. `age > 30 and age < 20`, `status:a and status:b`, `x and not x`
+// or `age > 20 or age <= 20`. The analysis assumes that the fields are present and hold values
+// of the type they are compared with: StructMatcher matches comparisons of missing fields, while SQL
+// never matches them. Fields holding several values, see WithColumnTypes, are only checked for
+// complementary operands like `tags:a and not tags:a`.
+//
+// Warnings implement error, so they can be combined with the errors returned by Validate.
+func Lint(expr Expr, opts ...AnalysisOption) []Warning {
+	l := linter{analysis: newAnalysis(opts, expr)}
+	l.expr(expr)
+
+	return l.warnings
+}
+
+type linter struct {
+	*analysis
+
+	warnings []Warning
+}
+
+func (l *linter) expr(expr Expr) {
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		if e == nil {
+			return
+		}
+
+		operands := junction(e, e.Op)
+		if e.Op == And || e.Op == Or {
+			l.chain(e, operands)
+		}
+
+		for _, operand := range operands {
+			l.expr(operand)
+		}
+	case *NotExpr:
+		if e != nil {
+			l.expr(e.Expr)
+		}
+	}
+}
+
+// chain checks the operands of the chain. An `or` chain always matches if the negations of its operands
+// never match together, so both are checked for contradictions, the latter with negated operands.
+func (l *linter) chain(root *BinaryExpr, operands []Expr) {
+	kind, positive := Contradiction, true
+	if root.Op == Or {
+		kind, positive = Tautology, false
+	}
+
+	for _, nodes := range l.complements(operands) {
+		l.warnings = append(l.warnings, Warning{Kind: kind, Expr: root, Nodes: nodes})
+	}
+
+	var (
+		fields      []Identifier
+		constraints = make(map[Identifier]*constraint)
+	)
+
+	for _, operand := range operands {
+		field, neg := literalField(operand)
+		if field == nil || l.multiValued(field.Field) {
+			continue
+		}
+
+		name := field.Field
+		if canonical, err := canonicalIdentifier(string(name)); err == nil {
+			name = canonical
+		}
+
+		c, ok := constraints[name]
+		if !ok {
+			c = newConstraint()
+		}
+
+		if !c.apply(field, positive != neg) {
+			continue
+		}
+
+		if !ok {
+			constraints[name] = c
+			fields = append(fields, name)
+		}
+
+		c.nodes = append(c.nodes, operand)
+	}
+
+	for _, name := range fields {
+		if c := constraints[name]; len(c.nodes) > 0 && c.empty() {
+			l.warnings = append(l.warnings, Warning{Kind: kind, Expr: root, Nodes: c.nodes})
+		}
+	}
+}
+
+// complements returns the pairs of operands where one is the negation of the other, e.g. `x` and `not x`.
+// Field expressions supported by constraint are skipped, so they aren't reported twice.
+func (l *linter) complements(operands []Expr) [][]Expr {
+	var pairs [][]Expr
+
+	for _, operand := range operands {
+		not, ok := operand.(*NotExpr)
+		if !ok || not == nil {
+			continue
+		}
+
+		if f, _ := literalField(not.Expr); f != nil && !l.multiValued(f.Field) && newConstraint().apply(f, true) {
+			continue
+		}
+
+		for _, other := range operands {
+			if Equals(not.Expr, other) {
+				pairs = append(pairs, []Expr{other, operand})
+				break
+			}
+		}
+	}
+
+	return pairs
+}
+
+// literalField returns the field expression of a possibly negated literal.
+func literalField(expr Expr) (*FieldExpr, bool) {
+	neg := false
+
+	for {
+		switch e := expr.(type) {
+		case *NotExpr:
+			if e == nil {
+				return nil, false
+			}
+			expr, neg = e.Expr, !neg
+		case *FieldExpr:
+			if e == nil || isNil(e.Value) {
+				return nil, false
+			}
+			return e, neg
+		default:
+			return nil, false
+		}
+	}
+}
+
+// constraint describes the values of a field allowed by the literals of a chain.
+type constraint struct {
+	nodes []Expr
+
+	in    map[string]Valuer // Allowed values, nil if unrestricted
+	notIn map[string]struct{}
+
+	lo, hi             float64 // Allowed numeric range
+	loStrict, hiStrict bool
+	ranged             bool
+}
+
+func newConstraint() *constraint {
+	return &constraint{lo: math.Inf(-1), hi: math.Inf(1), notIn: make(map[string]struct{})}
+}
+
+// apply narrows the constraint with the field expression or its negation. It reports whether
+// the expression is supported.
+func (c *constraint) apply(f *FieldExpr, positive bool) bool {
+	oneOf, isOneOf := f.Value.(*OneOfExpr)
+
+	switch f.Op { //nolint:exhaustive
+	case Equal:
+		values := []Valuer{f.Value}
+		if isOneOf {
+			values = oneOf.Values
+		}
+
+		if positive {
+			c.allow(values)
+		} else {
+			c.exclude(values)
+		}
+	case NotEqual:
+		if isOneOf {
+			return false
+		}
+
+		if positive {
+			c.exclude([]Valuer{f.Value})
+		} else {
+			c.allow([]Valuer{f.Value})
+		}
+	case GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual:
+		n, ok := f.Value.(*NumberLiteral)
+		if !ok || math.IsNaN(n.NumberValue) {
+			return false
+		}
+
+		c.bound(f.Op, n.NumberValue, positive)
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (c *constraint) allow(values []Valuer) {
+	allowed := make(map[string]Valuer, len(values))
+
+	for _, v := range values {
+		key := valueKey(v)
+		if _, ok := c.in[key]; ok || c.in == nil {
+			allowed[key] = v
+		}
+	}
+
+	c.in = allowed
+}
+
+func (c *constraint) exclude(values []Valuer) {
+	for _, v := range values {
+		c.notIn[valueKey(v)] = struct{}{}
+	}
+}
+
+// bound narrows the numeric range. The negation of a comparison is the opposite comparison,
+// e.g. `not x > 1` is `x <= 1`, since the values are assumed to be numbers.
+func (c *constraint) bound(op FieldOperator, n float64, positive bool) {
+	lower := op == GreaterThan || op == GreaterThanOrEqual
+	strict := op == GreaterThan || op == LessThan
+
+	if !positive {
+		lower, strict = !lower, !strict
+	}
+
+	c.ranged = true
+
+	switch {
+	case lower && (n > c.lo || (n == c.lo && strict)):
+		c.lo, c.loStrict = n, strict
+	case !lower && (n < c.hi || (n == c.hi && strict)):
+		c.hi, c.hiStrict = n, strict
+	}
+}
+
+// empty reports whether no value satisfies the constraint.
+func (c *constraint) empty() bool {
+	if c.lo > c.hi || (c.lo == c.hi && (c.loStrict || c.hiStrict)) {
+		return true
+	}
+
+	if c.in == nil {
+		// A range holding a single excluded value.
+		_, excluded := c.notIn[valueKey(&NumberLiteral{NumberValue: c.lo})]
+		return c.ranged && c.lo == c.hi && excluded
+	}
+
+	for key, v := range c.in {
+		if _, excluded := c.notIn[key]; !excluded && c.inRange(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *constraint) inRange(v Valuer) bool {
+	if !c.ranged {
+		return true
+	}
+
+	n, ok := v.(*NumberLiteral)
+	if !ok {
+		return false
+	}
+
+	x := n.NumberValue
+
+	return (x > c.lo || (x == c.lo && !c.loStrict)) && (x < c.hi || (x == c.hi && !c.hiStrict))
+}
@@ -0,0 +1,179 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/match"
+	"go.tomakado.io/dumbql/query"
+	"go.tomakado.io/dumbql/schema"
+)
+
+func TestLint(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		input string
+		want  []string // Kind, chain and nodes of the warnings
+	}{
+		// Contradictions.
+		{
+			input: `age > 30 and age < 20`,
+			want:  []string{`contradiction (and (> age 30) (< age 20)) [(> age 30) (< age 20)]`},
+		},
+		{
+			input: `status:a and status:b`,
+			want:  []string{`contradiction (and (= status "a") (= status "b")) [(= status "a") (= status "b")]`},
+		},
+		{
+			input: `age >= 20 and age <= 20 and age != 20`,
+			want: []string{
+				`contradiction (and (and (>= age 20) (<= age 20)) (!= age 20)) [(>= age 20) (<= age 20) (!= age 20)]`,
+			},
+		},
+		{
+			input: `age:[10, 20] and age > 25 and name:x`,
+			want:  []string{`contradiction (and (and (= age [10 20]) (> age 25)) (= name "x")) [(= age [10 20]) (> age 25)]`},
+		},
+		{
+			input: `a:[1, 2] and not a:[2, 1]`,
+			want:  []string{`contradiction (and (= a [1 2]) (not (= a [2 1]))) [(= a [1 2]) (not (= a [2 1]))]`},
+		},
+		{
+			input: `a:[]`,
+			want:  nil,
+		},
+		{
+			input: `a:[] and b:1`,
+			want:  []string{`contradiction (and (= a []) (= b 1)) [(= a [])]`},
+		},
+		{
+			input: `not age <= 30 and age < 30`,
+			want:  []string{`contradiction (and (not (<= age 30)) (< age 30)) [(not (<= age 30)) (< age 30)]`},
+		},
+		{
+			input: `email? and not email?`,
+			want: []string{
+				`contradiction (and (exists email true) (not (exists email true))) ` +
+					`[(exists email true) (not (exists email true))]`,
+			},
+		},
+		{
+			input: "x:1 or (`a`.b:1 and a.b:2)",
+			want:  []string{`contradiction (and (= a.b 1) (= a.b 2)) [(= a.b 1) (= a.b 2)]`},
+		},
+		// Tautologies.
+		{
+			input: `age > 20 or age < 30`,
+			want:  []string{`tautology (or (> age 20) (< age 30)) [(> age 20) (< age 30)]`},
+		},
+		{
+			input: `age > 20 or age <= 20`,
+			want:  []string{`tautology (or (> age 20) (<= age 20)) [(> age 20) (<= age 20)]`},
+		},
+		{
+			input: `a != 1 or a != 2`,
+			want:  []string{`tautology (or (!= a 1) (!= a 2)) [(!= a 1) (!= a 2)]`},
+		},
+		{
+			input: `name~x or not name~x`,
+			want:  []string{`tautology (or (~ name "x") (not (~ name "x"))) [(~ name "x") (not (~ name "x"))]`},
+		},
+		{
+			input: `status:a or not status:a`,
+			want:  []string{`tautology (or (= status "a") (not (= status "a"))) [(= status "a") (not (= status "a"))]`},
+		},
+		// Nothing suspicious.
+		{input: `age > 20 and age < 30`},
+		{input: `age > 20 or age > 30`},
+		{input: `age >= 20 and age <= 20`},
+		{input: `status:a or status:b`},
+		{input: `status:[a, b] and status:[b, c]`},
+		{input: `a != 1 and a != 2`},
+		{input: `name~x and name~y`},
+		{input: `age > 30 or (age < 20 and b:1)`},
+		{input: `age > 30 and a:1 or age < 20`},
+		{input: `a > "x" and a < "b"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			var got []string
+			for _, w := range query.Lint(mustParse(t, test.input)) {
+				got = append(got, w.Kind.String()+" "+w.Expr.String()+" "+sprintNodes(w.Nodes))
+			}
+
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func sprintNodes(nodes []query.Expr) string {
+	s := "["
+	for i, n := range nodes {
+		if i > 0 {
+			s += " "
+		}
+		s += n.String()
+	}
+	return s + "]"
+}
+
+func TestLint_Nested(t *testing.T) {
+	expr := mustParse(t, `not (a:1 and a:2) and (b > 1 or b < 2)`)
+
+	warnings := query.Lint(expr)
+	require.Len(t, warnings, 2)
+
+	assert.Equal(t, query.Contradiction, warnings[0].Kind)
+	assert.Same(t, expr.(*query.BinaryExpr).Left.(*query.NotExpr).Expr, warnings[0].Expr)
+	assert.Equal(t, query.Tautology, warnings[1].Kind)
+	assert.Same(t, expr.(*query.BinaryExpr).Right, warnings[1].Expr)
+}
+
+func TestLint_NilOperand(t *testing.T) {
+	expr := &query.BinaryExpr{
+		Left:  &query.BinaryExpr{Left: (*query.BinaryExpr)(nil), Op: query.And, Right: mustParse(t, `a:1`)},
+		Op:    query.And,
+		Right: mustParse(t, `a:2`),
+	}
+
+	warnings := query.Lint(expr)
+	require.Len(t, warnings, 1)
+	assert.Equal(t, query.Contradiction, warnings[0].Kind)
+}
+
+func TestLint_ArrayFields(t *testing.T) {
+	type record struct {
+		Tags []string `dumbql:"tags"`
+	}
+
+	types := query.WithColumnTypes(schema.ColumnTypes{"tags": {SQL: "text", Array: true}})
+	r := &record{Tags: []string{"a", "b"}}
+
+	for _, q := range []string{`tags:a and tags:b`, `tags:a and tags!=c and tags:[b, c]`, `tags:a and tags:[b, c]`} {
+		t.Run(q, func(t *testing.T) {
+			expr := mustParse(t, q)
+			assert.NotEmpty(t, query.Lint(expr))
+			assert.Empty(t, query.Lint(expr, types))
+		})
+	}
+
+	assert.True(t, mustParse(t, `tags:a and tags:b`).Match(r, &match.StructMatcher{}))
+
+	// Fields compared with `@>` hold several values without being declared.
+	assert.Empty(t, query.Lint(mustParse(t, `tags @> c and tags:a and tags:b`)))
+
+	// Complementary operands never match together whatever the field holds.
+	assert.Len(t, query.Lint(mustParse(t, `tags:a and not tags:a`), types), 1)
+}
+
+func TestWarning_Error(t *testing.T) {
+	warnings := query.Lint(mustParse(t, `(a:1 and a:2) or (b>1 or b<2)`))
+	require.Len(t, warnings, 2)
+
+	// The whole `or` chain is reported before its operands.
+	assert.EqualError(t, warnings[0],
+		`(or (and (= a 1) (= a 2)) (or (> b 1) (< b 2))) always matches: [(> b 1) (< b 2)] cover all values`)
+	assert.EqualError(t, warnings[1], `(and (= a 1) (= a 2)) never matches: [(= a 1) (= a 2)] exclude each other`)
+	assert.Equal(t, "unknown!", query.WarningKind(42).String())
+}
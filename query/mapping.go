@@ -0,0 +1,156 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/multierr"
+)
+
+// ErrUnmappedField is reported by FieldMapping.Apply in strict mode for fields missing from the mapping.
+var ErrUnmappedField = errors.New("field is not mapped")
+
+// DeprecatedFieldError is reported by FieldMapping.Apply for every usage of a deprecated field name.
+type DeprecatedFieldError struct {
+	Field   Identifier // Deprecated public name used in the query
+	Message string     // Hint for the user, e.g. "use user.email instead"
+}
+
+func (e *DeprecatedFieldError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("field %q is deprecated", e.Field)
+	}
+
+	return fmt.Sprintf("field %q is deprecated: %s", e.Field, e.Message)
+}
+
+// FieldTarget defines the storage paths a public field name maps to.
+type FieldTarget struct {
+	Paths      []string // Storage paths, e.g. SQL columns or router paths; several paths expand into an `or`, see ToAny
+	Deprecated bool     // Whether the public name is deprecated
+	Message    string   // Deprecation hint reported with DeprecatedFieldError
+}
+
+// To maps a public field name to the storage path.
+func To(path string) FieldTarget {
+	return FieldTarget{Paths: []string{path}}
+}
+
+// ToAny maps a public field name to several storage paths. A field expression matches if it matches
+// any of them, e.g. `name~john` becomes `first_name~john or last_name~john`. Negated operators expand
+// into an `and`, so `name!=john` becomes `first_name!=john and last_name!=john`, the same as
+// `not name:john`.
+func ToAny(paths ...string) FieldTarget {
+	return FieldTarget{Paths: paths}
+}
+
+// Deprecate marks the public name as deprecated, with an optional hint for the user.
+func (t FieldTarget) Deprecate(message string) FieldTarget {
+	t.Deprecated, t.Message = true, message
+	return t
+}
+
+// FieldMapping translates the field names of the public query language into storage names, so queries
+// can be written against a stable API while columns and struct fields change. Apply it to an expression
+// before ToSql or Match.
+//
+// Aliases and renamed fields are separate entries mapping to the same path. Mapped names also apply
+// to nested paths, e.g. with `user` mapped to `u`, `user.email` becomes `u.email`, unless the nested path
+// is mapped itself: the longest mapped prefix wins.
+type FieldMapping struct {
+	Fields map[string]FieldTarget
+	Strict bool // Drop and report fields which are not mapped instead of keeping them as is
+}
+
+// Apply returns the expression with the field names translated. It never modifies the input.
+//
+// Like Validate, Apply returns the translated expression along with errors: unmapped fields in strict
+// mode are dropped and reported with ErrUnmappedField, deprecated names are translated and reported
+// with DeprecatedFieldError. Errors are combined with multierr, use errors.Is and errors.As to inspect them.
+// Invalid paths in the mapping are reported as errors and yield a nil expression.
+func (m FieldMapping) Apply(expr Expr) (Expr, error) {
+	fields := make(map[Identifier]FieldTarget, len(m.Fields))
+
+	for name, target := range m.Fields {
+		id, err := canonicalIdentifier(name)
+		if err != nil {
+			return nil, fmt.Errorf("mapping: %w", err)
+		}
+
+		if len(target.Paths) == 0 {
+			return nil, fmt.Errorf("mapping: field %q has no paths", name)
+		}
+
+		for _, path := range target.Paths {
+			if _, err := canonicalIdentifier(path); err != nil {
+				return nil, fmt.Errorf("mapping: field %q: %w", name, err)
+			}
+		}
+
+		fields[id] = target
+	}
+
+	var errs error
+
+	res := RewriteExpr(expr, func(node Node) Node {
+		f, ok := node.(*FieldExpr)
+		if !ok {
+			return node
+		}
+
+		mapped, err := m.field(fields, f)
+		errs = multierr.Append(errs, err)
+
+		return mapped
+	})
+
+	return res, errs
+}
+
+// field translates a single field expression. It returns nil if the field has to be dropped.
+func (m FieldMapping) field(fields map[Identifier]FieldTarget, f *FieldExpr) (Expr, error) {
+	// Malformed paths can't be mapped, they are left as is for Validate and ToSql to report.
+	segments, ok := segmentsOf(f.Field)
+	if !ok {
+		return f, nil
+	}
+
+	// Look for the longest mapped prefix of the path.
+	for n := len(segments); n > 0; n-- {
+		target, ok := fields[joinSegments(segments[:n])]
+		if !ok {
+			continue
+		}
+
+		var err error
+		if target.Deprecated {
+			err = &DeprecatedFieldError{Field: joinSegments(segments[:n]), Message: target.Message}
+		}
+
+		rest := joinSegments(segments[n:])
+		if rest != "" && !strings.HasPrefix(string(rest), "[") {
+			rest = "." + rest
+		}
+
+		var res Builder
+		for _, path := range target.Paths {
+			field, _ := canonicalIdentifier(path + string(rest))
+			mapped := From(&FieldExpr{Field: field, Op: f.Op, Value: f.Value})
+
+			if f.Op == NotEqual {
+				res = res.And(mapped)
+			} else {
+				res = res.Or(mapped)
+			}
+		}
+
+		return res.Expr(), err
+	}
+
+	if m.Strict {
+		return nil, fmt.Errorf("%w: %q", ErrUnmappedField, f.Field)
+	}
+
+	return f, nil
+}
@@ -0,0 +1,122 @@
+package query_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+	"go.uber.org/multierr"
+)
+
+func TestFieldMapping_Apply(t *testing.T) { //nolint:funlen
+	mapping := query.FieldMapping{
+		Fields: map[string]query.FieldTarget{
+			"user.email":     query.To("u.email_address"),
+			"mail":           query.To("u.email_address").Deprecate("use user.email instead"),
+			"name":           query.ToAny("u.first_name", "u.last_name"),
+			"profile":        query.To("p"),
+			"profile.`x-id`": query.To("p.external_id"),
+			"labels":         query.To("meta.`labels`"),
+		},
+	}
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: `user.email:"a@b.c"`,
+			want:  `(= u.email_address "a@b.c")`,
+		},
+		{
+			input: `name~john and not name:bob`,
+			want: `(and (or (~ u.first_name "john") (~ u.last_name "john"))` +
+				` (not (or (= u.first_name "bob") (= u.last_name "bob"))))`,
+		},
+		{
+			input: `name!=john or name!=[bob, tom]`,
+			want: `(or (and (!= u.first_name "john") (!= u.last_name "john"))` +
+				` (and (!= u.first_name ["bob" "tom"]) (!= u.last_name ["bob" "tom"])))`,
+		},
+		{
+			input: `profile.age > 18 and profile?`,
+			want:  `(and (> p.age 18) (exists p true))`,
+		},
+		{
+			input: "profile.`x-id`:1 and profile.`x-id`.y:2",
+			want:  `(and (= p.external_id 1) (= p.external_id.y 2))`,
+		},
+		{
+			input: `labels["app"]:web and labels[0]:x`,
+			want:  `(and (= meta.labels["app"] "web") (= meta.labels[0] "x"))`,
+		},
+		{
+			input: `unknown:1 or user:2`,
+			want:  `(or (= unknown 1) (= user 2))`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			expr := mustParse(t, test.input)
+			before := expr.String()
+
+			got, err := mapping.Apply(expr)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got.String())
+			assert.Equal(t, before, expr.String(), "input tree must not be modified")
+		})
+	}
+}
+
+func TestFieldMapping_Deprecated(t *testing.T) {
+	mapping := query.FieldMapping{
+		Fields: map[string]query.FieldTarget{
+			"mail":  query.To("email").Deprecate("use email instead"),
+			"phone": query.To("tel").Deprecate(""),
+		},
+	}
+
+	got, err := mapping.Apply(mustParse(t, `mail:x and phone?`))
+	assert.Equal(t, `(and (= email "x") (exists tel true))`, got.String())
+
+	errs := multierr.Errors(err)
+	require.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], `field "mail" is deprecated: use email instead`)
+	assert.EqualError(t, errs[1], `field "phone" is deprecated`)
+
+	var deprecated *query.DeprecatedFieldError
+	require.ErrorAs(t, err, &deprecated)
+	assert.Equal(t, query.Identifier("mail"), deprecated.Field)
+}
+
+func TestFieldMapping_Strict(t *testing.T) {
+	mapping := query.FieldMapping{
+		Fields: map[string]query.FieldTarget{"a": query.To("x")},
+		Strict: true,
+	}
+
+	got, err := mapping.Apply(mustParse(t, `a:1 and (b:2 or not c:3)`))
+	assert.Equal(t, `(= x 1)`, got.String())
+	require.ErrorIs(t, err, query.ErrUnmappedField)
+	assert.Len(t, multierr.Errors(err), 2)
+
+	got, err = mapping.Apply(mustParse(t, `b:2`))
+	assert.Nil(t, got)
+	require.ErrorIs(t, err, query.ErrUnmappedField)
+}
+
+func TestFieldMapping_InvalidMapping(t *testing.T) {
+	for _, fields := range []map[string]query.FieldTarget{
+		{"a..b": query.To("x")},
+		{"a": query.To("x..y")},
+		{"a": query.ToAny()},
+	} {
+		got, err := query.FieldMapping{Fields: fields}.Apply(mustParse(t, `a:1`))
+		assert.Nil(t, got)
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, query.ErrUnmappedField))
+	}
+}
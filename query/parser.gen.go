@@ -28,9 +28,9 @@ var g = &grammar{
 					pos: position{line: 5, col: 24, offset: 46},
 					exprs: []any{
 						&zeroOrMoreExpr{
-							pos: position{line: 37, col: 24, offset: 2532},
+							pos: position{line: 44, col: 24, offset: 2941},
 							expr: &charClassMatcher{
-								pos:        position{line: 37, col: 24, offset: 2532},
+								pos:        position{line: 44, col: 24, offset: 2941},
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -46,9 +46,9 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 37, col: 24, offset: 2532},
+							pos: position{line: 44, col: 24, offset: 2941},
 							expr: &charClassMatcher{
-								pos:        position{line: 37, col: 24, offset: 2532},
+								pos:        position{line: 44, col: 24, offset: 2941},
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -85,9 +85,9 @@ var g = &grammar{
 									pos: position{line: 6, col: 43, offset: 160},
 									exprs: []any{
 										&zeroOrMoreExpr{
-											pos: position{line: 37, col: 24, offset: 2532},
+											pos: position{line: 44, col: 24, offset: 2941},
 											expr: &charClassMatcher{
-												pos:        position{line: 37, col: 24, offset: 2532},
+												pos:        position{line: 44, col: 24, offset: 2941},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
@@ -112,9 +112,9 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 37, col: 24, offset: 2532},
+											pos: position{line: 44, col: 24, offset: 2941},
 											expr: &charClassMatcher{
-												pos:        position{line: 37, col: 24, offset: 2532},
+												pos:        position{line: 44, col: 24, offset: 2941},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
@@ -159,9 +159,9 @@ var g = &grammar{
 									pos: position{line: 8, col: 43, offset: 320},
 									exprs: []any{
 										&zeroOrMoreExpr{
-											pos: position{line: 37, col: 24, offset: 2532},
+											pos: position{line: 44, col: 24, offset: 2941},
 											expr: &charClassMatcher{
-												pos:        position{line: 37, col: 24, offset: 2532},
+												pos:        position{line: 44, col: 24, offset: 2941},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
@@ -190,9 +190,9 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 37, col: 24, offset: 2532},
+											pos: position{line: 44, col: 24, offset: 2941},
 											expr: &charClassMatcher{
-												pos:        position{line: 37, col: 24, offset: 2532},
+												pos:        position{line: 44, col: 24, offset: 2941},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
@@ -241,9 +241,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 37, col: 24, offset: 2532},
+									pos: position{line: 44, col: 24, offset: 2941},
 									expr: &charClassMatcher{
-										pos:        position{line: 37, col: 24, offset: 2532},
+										pos:        position{line: 44, col: 24, offset: 2941},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
@@ -288,58 +288,406 @@ var g = &grammar{
 									pos:   position{line: 14, col: 24, offset: 794},
 									label: "field",
 									expr: &actionExpr{
-										pos: position{line: 20, col: 24, offset: 1339},
+										pos: position{line: 21, col: 24, offset: 1451},
 										run: (*parser).callonPrimary6,
 										expr: &seqExpr{
-											pos: position{line: 20, col: 24, offset: 1339},
+											pos: position{line: 21, col: 24, offset: 1451},
 											exprs: []any{
-												&charClassMatcher{
-													pos:        position{line: 21, col: 24, offset: 1451},
-													val:        "[_a-zA-Z]",
-													chars:      []rune{'_'},
-													ranges:     []rune{'a', 'z', 'A', 'Z'},
-													ignoreCase: false,
-													inverted:   false,
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 21, col: 33, offset: 1460},
-													expr: &charClassMatcher{
-														pos:        position{line: 21, col: 33, offset: 1460},
-														val:        "[_a-zA-Z0-9]",
-														chars:      []rune{'_'},
-														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
-														ignoreCase: false,
-														inverted:   false,
+												&choiceExpr{
+													pos: position{line: 24, col: 24, offset: 1660},
+													alternatives: []any{
+														&seqExpr{
+															pos: position{line: 28, col: 24, offset: 1853},
+															exprs: []any{
+																&charClassMatcher{
+																	pos:        position{line: 28, col: 24, offset: 1853},
+																	val:        "[_a-zA-Z]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'a', 'z', 'A', 'Z'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+																&zeroOrMoreExpr{
+																	pos: position{line: 28, col: 33, offset: 1862},
+																	expr: &charClassMatcher{
+																		pos:        position{line: 28, col: 33, offset: 1862},
+																		val:        "[_a-zA-Z0-9]",
+																		chars:      []rune{'_'},
+																		ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+														&seqExpr{
+															pos: position{line: 25, col: 24, offset: 1709},
+															exprs: []any{
+																&litMatcher{
+																	pos:        position{line: 25, col: 24, offset: 1709},
+																	val:        "`",
+																	ignoreCase: false,
+																	want:       "\"`\"",
+																},
+																&oneOrMoreExpr{
+																	pos: position{line: 25, col: 28, offset: 1713},
+																	expr: &choiceExpr{
+																		pos: position{line: 25, col: 30, offset: 1715},
+																		alternatives: []any{
+																			&seqExpr{
+																				pos: position{line: 25, col: 30, offset: 1715},
+																				exprs: []any{
+																					&notExpr{
+																						pos: position{line: 25, col: 30, offset: 1715},
+																						expr: &charClassMatcher{
+																							pos:        position{line: 26, col: 24, offset: 1786},
+																							val:        "[`\\\\\\x00-\\x1f]",
+																							chars:      []rune{'`', '\\'},
+																							ranges:     []rune{'\x00', '\x1f'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																					&anyMatcher{
+																						line: 25, col: 49, offset: 1734,
+																					},
+																				},
+																			},
+																			&seqExpr{
+																				pos: position{line: 25, col: 53, offset: 1738},
+																				exprs: []any{
+																					&litMatcher{
+																						pos:        position{line: 25, col: 53, offset: 1738},
+																						val:        "\\",
+																						ignoreCase: false,
+																						want:       "\"\\\\\"",
+																					},
+																					&charClassMatcher{
+																						pos:        position{line: 27, col: 24, offset: 1824},
+																						val:        "[`\\\\]",
+																						chars:      []rune{'`', '\\'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+																&litMatcher{
+																	pos:        position{line: 25, col: 74, offset: 1759},
+																	val:        "`",
+																	ignoreCase: false,
+																	want:       "\"`\"",
+																},
+															},
+														},
 													},
 												},
 												&zeroOrMoreExpr{
-													pos: position{line: 20, col: 37, offset: 1352},
-													expr: &seqExpr{
-														pos: position{line: 20, col: 38, offset: 1353},
-														exprs: []any{
-															&litMatcher{
-																pos:        position{line: 20, col: 38, offset: 1353},
-																val:        ".",
-																ignoreCase: false,
-																want:       "\".\"",
-															},
-															&charClassMatcher{
-																pos:        position{line: 21, col: 24, offset: 1451},
-																val:        "[_a-zA-Z]",
-																chars:      []rune{'_'},
-																ranges:     []rune{'a', 'z', 'A', 'Z'},
-																ignoreCase: false,
-																inverted:   false,
+													pos: position{line: 21, col: 34, offset: 1461},
+													expr: &choiceExpr{
+														pos: position{line: 22, col: 24, offset: 1553},
+														alternatives: []any{
+															&seqExpr{
+																pos: position{line: 22, col: 24, offset: 1553},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 22, col: 24, offset: 1553},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&choiceExpr{
+																		pos: position{line: 24, col: 24, offset: 1660},
+																		alternatives: []any{
+																			&seqExpr{
+																				pos: position{line: 28, col: 24, offset: 1853},
+																				exprs: []any{
+																					&charClassMatcher{
+																						pos:        position{line: 28, col: 24, offset: 1853},
+																						val:        "[_a-zA-Z]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&zeroOrMoreExpr{
+																						pos: position{line: 28, col: 33, offset: 1862},
+																						expr: &charClassMatcher{
+																							pos:        position{line: 28, col: 33, offset: 1862},
+																							val:        "[_a-zA-Z0-9]",
+																							chars:      []rune{'_'},
+																							ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																			&seqExpr{
+																				pos: position{line: 25, col: 24, offset: 1709},
+																				exprs: []any{
+																					&litMatcher{
+																						pos:        position{line: 25, col: 24, offset: 1709},
+																						val:        "`",
+																						ignoreCase: false,
+																						want:       "\"`\"",
+																					},
+																					&oneOrMoreExpr{
+																						pos: position{line: 25, col: 28, offset: 1713},
+																						expr: &choiceExpr{
+																							pos: position{line: 25, col: 30, offset: 1715},
+																							alternatives: []any{
+																								&seqExpr{
+																									pos: position{line: 25, col: 30, offset: 1715},
+																									exprs: []any{
+																										&notExpr{
+																											pos: position{line: 25, col: 30, offset: 1715},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 26, col: 24, offset: 1786},
+																												val:        "[`\\\\\\x00-\\x1f]",
+																												chars:      []rune{'`', '\\'},
+																												ranges:     []rune{'\x00', '\x1f'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&anyMatcher{
+																											line: 25, col: 49, offset: 1734,
+																										},
+																									},
+																								},
+																								&seqExpr{
+																									pos: position{line: 25, col: 53, offset: 1738},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 25, col: 53, offset: 1738},
+																											val:        "\\",
+																											ignoreCase: false,
+																											want:       "\"\\\\\"",
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 27, col: 24, offset: 1824},
+																											val:        "[`\\\\]",
+																											chars:      []rune{'`', '\\'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 25, col: 74, offset: 1759},
+																						val:        "`",
+																						ignoreCase: false,
+																						want:       "\"`\"",
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
 															},
-															&zeroOrMoreExpr{
-																pos: position{line: 21, col: 33, offset: 1460},
-																expr: &charClassMatcher{
-																	pos:        position{line: 21, col: 33, offset: 1460},
-																	val:        "[_a-zA-Z0-9]",
-																	chars:      []rune{'_'},
-																	ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
-																	ignoreCase: false,
-																	inverted:   false,
+															&seqExpr{
+																pos: position{line: 22, col: 40, offset: 1569},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 22, col: 40, offset: 1569},
+																		val:        "[",
+																		ignoreCase: false,
+																		want:       "\"[\"",
+																	},
+																	&zeroOrMoreExpr{
+																		pos: position{line: 44, col: 24, offset: 2941},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 44, col: 24, offset: 2941},
+																			val:        "[ \\t\\r\\n]",
+																			chars:      []rune{' ', '\t', '\r', '\n'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&choiceExpr{
+																		pos: position{line: 23, col: 24, offset: 1615},
+																		alternatives: []any{
+																			&seqExpr{
+																				pos: position{line: 23, col: 24, offset: 1615},
+																				exprs: []any{
+																					&zeroOrOneExpr{
+																						pos: position{line: 23, col: 24, offset: 1615},
+																						expr: &litMatcher{
+																							pos:        position{line: 23, col: 24, offset: 1615},
+																							val:        "-",
+																							ignoreCase: false,
+																							want:       "\"-\"",
+																						},
+																					},
+																					&choiceExpr{
+																						pos: position{line: 29, col: 24, offset: 1899},
+																						alternatives: []any{
+																							&litMatcher{
+																								pos:        position{line: 29, col: 24, offset: 1899},
+																								val:        "0",
+																								ignoreCase: false,
+																								want:       "\"0\"",
+																							},
+																							&seqExpr{
+																								pos: position{line: 29, col: 30, offset: 1905},
+																								exprs: []any{
+																									&charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2094},
+																										val:        "[1-9]",
+																										ranges:     []rune{'1', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&zeroOrMoreExpr{
+																										pos: position{line: 29, col: 50, offset: 1925},
+																										expr: &charClassMatcher{
+																											pos:        position{line: 31, col: 24, offset: 2065},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																			&actionExpr{
+																				pos: position{line: 33, col: 24, offset: 2123},
+																				run: (*parser).callonPrimary60,
+																				expr: &seqExpr{
+																					pos: position{line: 33, col: 24, offset: 2123},
+																					exprs: []any{
+																						&litMatcher{
+																							pos:        position{line: 33, col: 24, offset: 2123},
+																							val:        "\"",
+																							ignoreCase: false,
+																							want:       "\"\\\"\"",
+																						},
+																						&zeroOrMoreExpr{
+																							pos: position{line: 34, col: 24, offset: 2226},
+																							expr: &choiceExpr{
+																								pos: position{line: 34, col: 26, offset: 2228},
+																								alternatives: []any{
+																									&seqExpr{
+																										pos: position{line: 34, col: 26, offset: 2228},
+																										exprs: []any{
+																											&notExpr{
+																												pos: position{line: 34, col: 26, offset: 2228},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 35, col: 24, offset: 2291},
+																													val:        "[\"\\\\\\x00-\\x1f]",
+																													chars:      []rune{'"', '\\'},
+																													ranges:     []rune{'\x00', '\x1f'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																											&anyMatcher{
+																												line: 34, col: 39, offset: 2241,
+																											},
+																										},
+																									},
+																									&seqExpr{
+																										pos: position{line: 34, col: 43, offset: 2245},
+																										exprs: []any{
+																											&litMatcher{
+																												pos:        position{line: 34, col: 43, offset: 2245},
+																												val:        "\\",
+																												ignoreCase: false,
+																												want:       "\"\\\\\"",
+																											},
+																											&choiceExpr{
+																												pos: position{line: 36, col: 24, offset: 2329},
+																												alternatives: []any{
+																													&charClassMatcher{
+																														pos:        position{line: 37, col: 24, offset: 2385},
+																														val:        "[\"\\\\/bfnrt]",
+																														chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&seqExpr{
+																														pos: position{line: 38, col: 24, offset: 2420},
+																														exprs: []any{
+																															&litMatcher{
+																																pos:        position{line: 38, col: 24, offset: 2420},
+																																val:        "u",
+																																ignoreCase: false,
+																																want:       "\"u\"",
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 39, col: 24, offset: 2483},
+																																val:        "[0-9a-f]i",
+																																ranges:     []rune{'0', '9', 'a', 'f'},
+																																ignoreCase: true,
+																																inverted:   false,
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 39, col: 24, offset: 2483},
+																																val:        "[0-9a-f]i",
+																																ranges:     []rune{'0', '9', 'a', 'f'},
+																																ignoreCase: true,
+																																inverted:   false,
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 39, col: 24, offset: 2483},
+																																val:        "[0-9a-f]i",
+																																ranges:     []rune{'0', '9', 'a', 'f'},
+																																ignoreCase: true,
+																																inverted:   false,
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 39, col: 24, offset: 2483},
+																																val:        "[0-9a-f]i",
+																																ranges:     []rune{'0', '9', 'a', 'f'},
+																																ignoreCase: true,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																						&litMatcher{
+																							pos:        position{line: 33, col: 40, offset: 2139},
+																							val:        "\"",
+																							ignoreCase: false,
+																							want:       "\"\\\"\"",
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&zeroOrMoreExpr{
+																		pos: position{line: 44, col: 24, offset: 2941},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 44, col: 24, offset: 2941},
+																			val:        "[ \\t\\r\\n]",
+																			chars:      []rune{' ', '\t', '\r', '\n'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 22, col: 59, offset: 1588},
+																		val:        "]",
+																		ignoreCase: false,
+																		want:       "\"]\"",
+																	},
 																},
 															},
 														},
@@ -350,9 +698,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 37, col: 24, offset: 2532},
+									pos: position{line: 44, col: 24, offset: 2941},
 									expr: &charClassMatcher{
-										pos:        position{line: 37, col: 24, offset: 2532},
+										pos:        position{line: 44, col: 24, offset: 2941},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
@@ -387,7 +735,7 @@ var g = &grammar{
 					},
 					&actionExpr{
 						pos: position{line: 16, col: 24, offset: 962},
-						run: (*parser).callonPrimary23,
+						run: (*parser).callonPrimary89,
 						expr: &seqExpr{
 							pos: position{line: 16, col: 24, offset: 962},
 							exprs: []any{
@@ -395,58 +743,406 @@ var g = &grammar{
 									pos:   position{line: 16, col: 24, offset: 962},
 									label: "field",
 									expr: &actionExpr{
-										pos: position{line: 20, col: 24, offset: 1339},
-										run: (*parser).callonPrimary26,
+										pos: position{line: 21, col: 24, offset: 1451},
+										run: (*parser).callonPrimary92,
 										expr: &seqExpr{
-											pos: position{line: 20, col: 24, offset: 1339},
+											pos: position{line: 21, col: 24, offset: 1451},
 											exprs: []any{
-												&charClassMatcher{
-													pos:        position{line: 21, col: 24, offset: 1451},
-													val:        "[_a-zA-Z]",
-													chars:      []rune{'_'},
-													ranges:     []rune{'a', 'z', 'A', 'Z'},
-													ignoreCase: false,
-													inverted:   false,
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 21, col: 33, offset: 1460},
-													expr: &charClassMatcher{
-														pos:        position{line: 21, col: 33, offset: 1460},
-														val:        "[_a-zA-Z0-9]",
-														chars:      []rune{'_'},
-														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
-														ignoreCase: false,
-														inverted:   false,
+												&choiceExpr{
+													pos: position{line: 24, col: 24, offset: 1660},
+													alternatives: []any{
+														&seqExpr{
+															pos: position{line: 28, col: 24, offset: 1853},
+															exprs: []any{
+																&charClassMatcher{
+																	pos:        position{line: 28, col: 24, offset: 1853},
+																	val:        "[_a-zA-Z]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'a', 'z', 'A', 'Z'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+																&zeroOrMoreExpr{
+																	pos: position{line: 28, col: 33, offset: 1862},
+																	expr: &charClassMatcher{
+																		pos:        position{line: 28, col: 33, offset: 1862},
+																		val:        "[_a-zA-Z0-9]",
+																		chars:      []rune{'_'},
+																		ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+														&seqExpr{
+															pos: position{line: 25, col: 24, offset: 1709},
+															exprs: []any{
+																&litMatcher{
+																	pos:        position{line: 25, col: 24, offset: 1709},
+																	val:        "`",
+																	ignoreCase: false,
+																	want:       "\"`\"",
+																},
+																&oneOrMoreExpr{
+																	pos: position{line: 25, col: 28, offset: 1713},
+																	expr: &choiceExpr{
+																		pos: position{line: 25, col: 30, offset: 1715},
+																		alternatives: []any{
+																			&seqExpr{
+																				pos: position{line: 25, col: 30, offset: 1715},
+																				exprs: []any{
+																					&notExpr{
+																						pos: position{line: 25, col: 30, offset: 1715},
+																						expr: &charClassMatcher{
+																							pos:        position{line: 26, col: 24, offset: 1786},
+																							val:        "[`\\\\\\x00-\\x1f]",
+																							chars:      []rune{'`', '\\'},
+																							ranges:     []rune{'\x00', '\x1f'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																					&anyMatcher{
+																						line: 25, col: 49, offset: 1734,
+																					},
+																				},
+																			},
+																			&seqExpr{
+																				pos: position{line: 25, col: 53, offset: 1738},
+																				exprs: []any{
+																					&litMatcher{
+																						pos:        position{line: 25, col: 53, offset: 1738},
+																						val:        "\\",
+																						ignoreCase: false,
+																						want:       "\"\\\\\"",
+																					},
+																					&charClassMatcher{
+																						pos:        position{line: 27, col: 24, offset: 1824},
+																						val:        "[`\\\\]",
+																						chars:      []rune{'`', '\\'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+																&litMatcher{
+																	pos:        position{line: 25, col: 74, offset: 1759},
+																	val:        "`",
+																	ignoreCase: false,
+																	want:       "\"`\"",
+																},
+															},
+														},
 													},
 												},
 												&zeroOrMoreExpr{
-													pos: position{line: 20, col: 37, offset: 1352},
-													expr: &seqExpr{
-														pos: position{line: 20, col: 38, offset: 1353},
-														exprs: []any{
-															&litMatcher{
-																pos:        position{line: 20, col: 38, offset: 1353},
-																val:        ".",
-																ignoreCase: false,
-																want:       "\".\"",
-															},
-															&charClassMatcher{
-																pos:        position{line: 21, col: 24, offset: 1451},
-																val:        "[_a-zA-Z]",
-																chars:      []rune{'_'},
-																ranges:     []rune{'a', 'z', 'A', 'Z'},
-																ignoreCase: false,
-																inverted:   false,
+													pos: position{line: 21, col: 34, offset: 1461},
+													expr: &choiceExpr{
+														pos: position{line: 22, col: 24, offset: 1553},
+														alternatives: []any{
+															&seqExpr{
+																pos: position{line: 22, col: 24, offset: 1553},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 22, col: 24, offset: 1553},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&choiceExpr{
+																		pos: position{line: 24, col: 24, offset: 1660},
+																		alternatives: []any{
+																			&seqExpr{
+																				pos: position{line: 28, col: 24, offset: 1853},
+																				exprs: []any{
+																					&charClassMatcher{
+																						pos:        position{line: 28, col: 24, offset: 1853},
+																						val:        "[_a-zA-Z]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&zeroOrMoreExpr{
+																						pos: position{line: 28, col: 33, offset: 1862},
+																						expr: &charClassMatcher{
+																							pos:        position{line: 28, col: 33, offset: 1862},
+																							val:        "[_a-zA-Z0-9]",
+																							chars:      []rune{'_'},
+																							ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																			&seqExpr{
+																				pos: position{line: 25, col: 24, offset: 1709},
+																				exprs: []any{
+																					&litMatcher{
+																						pos:        position{line: 25, col: 24, offset: 1709},
+																						val:        "`",
+																						ignoreCase: false,
+																						want:       "\"`\"",
+																					},
+																					&oneOrMoreExpr{
+																						pos: position{line: 25, col: 28, offset: 1713},
+																						expr: &choiceExpr{
+																							pos: position{line: 25, col: 30, offset: 1715},
+																							alternatives: []any{
+																								&seqExpr{
+																									pos: position{line: 25, col: 30, offset: 1715},
+																									exprs: []any{
+																										&notExpr{
+																											pos: position{line: 25, col: 30, offset: 1715},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 26, col: 24, offset: 1786},
+																												val:        "[`\\\\\\x00-\\x1f]",
+																												chars:      []rune{'`', '\\'},
+																												ranges:     []rune{'\x00', '\x1f'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&anyMatcher{
+																											line: 25, col: 49, offset: 1734,
+																										},
+																									},
+																								},
+																								&seqExpr{
+																									pos: position{line: 25, col: 53, offset: 1738},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 25, col: 53, offset: 1738},
+																											val:        "\\",
+																											ignoreCase: false,
+																											want:       "\"\\\\\"",
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 27, col: 24, offset: 1824},
+																											val:        "[`\\\\]",
+																											chars:      []rune{'`', '\\'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 25, col: 74, offset: 1759},
+																						val:        "`",
+																						ignoreCase: false,
+																						want:       "\"`\"",
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
 															},
-															&zeroOrMoreExpr{
-																pos: position{line: 21, col: 33, offset: 1460},
-																expr: &charClassMatcher{
-																	pos:        position{line: 21, col: 33, offset: 1460},
-																	val:        "[_a-zA-Z0-9]",
-																	chars:      []rune{'_'},
-																	ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
-																	ignoreCase: false,
-																	inverted:   false,
+															&seqExpr{
+																pos: position{line: 22, col: 40, offset: 1569},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 22, col: 40, offset: 1569},
+																		val:        "[",
+																		ignoreCase: false,
+																		want:       "\"[\"",
+																	},
+																	&zeroOrMoreExpr{
+																		pos: position{line: 44, col: 24, offset: 2941},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 44, col: 24, offset: 2941},
+																			val:        "[ \\t\\r\\n]",
+																			chars:      []rune{' ', '\t', '\r', '\n'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&choiceExpr{
+																		pos: position{line: 23, col: 24, offset: 1615},
+																		alternatives: []any{
+																			&seqExpr{
+																				pos: position{line: 23, col: 24, offset: 1615},
+																				exprs: []any{
+																					&zeroOrOneExpr{
+																						pos: position{line: 23, col: 24, offset: 1615},
+																						expr: &litMatcher{
+																							pos:        position{line: 23, col: 24, offset: 1615},
+																							val:        "-",
+																							ignoreCase: false,
+																							want:       "\"-\"",
+																						},
+																					},
+																					&choiceExpr{
+																						pos: position{line: 29, col: 24, offset: 1899},
+																						alternatives: []any{
+																							&litMatcher{
+																								pos:        position{line: 29, col: 24, offset: 1899},
+																								val:        "0",
+																								ignoreCase: false,
+																								want:       "\"0\"",
+																							},
+																							&seqExpr{
+																								pos: position{line: 29, col: 30, offset: 1905},
+																								exprs: []any{
+																									&charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2094},
+																										val:        "[1-9]",
+																										ranges:     []rune{'1', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&zeroOrMoreExpr{
+																										pos: position{line: 29, col: 50, offset: 1925},
+																										expr: &charClassMatcher{
+																											pos:        position{line: 31, col: 24, offset: 2065},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																			&actionExpr{
+																				pos: position{line: 33, col: 24, offset: 2123},
+																				run: (*parser).callonPrimary146,
+																				expr: &seqExpr{
+																					pos: position{line: 33, col: 24, offset: 2123},
+																					exprs: []any{
+																						&litMatcher{
+																							pos:        position{line: 33, col: 24, offset: 2123},
+																							val:        "\"",
+																							ignoreCase: false,
+																							want:       "\"\\\"\"",
+																						},
+																						&zeroOrMoreExpr{
+																							pos: position{line: 34, col: 24, offset: 2226},
+																							expr: &choiceExpr{
+																								pos: position{line: 34, col: 26, offset: 2228},
+																								alternatives: []any{
+																									&seqExpr{
+																										pos: position{line: 34, col: 26, offset: 2228},
+																										exprs: []any{
+																											&notExpr{
+																												pos: position{line: 34, col: 26, offset: 2228},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 35, col: 24, offset: 2291},
+																													val:        "[\"\\\\\\x00-\\x1f]",
+																													chars:      []rune{'"', '\\'},
+																													ranges:     []rune{'\x00', '\x1f'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																											&anyMatcher{
+																												line: 34, col: 39, offset: 2241,
+																											},
+																										},
+																									},
+																									&seqExpr{
+																										pos: position{line: 34, col: 43, offset: 2245},
+																										exprs: []any{
+																											&litMatcher{
+																												pos:        position{line: 34, col: 43, offset: 2245},
+																												val:        "\\",
+																												ignoreCase: false,
+																												want:       "\"\\\\\"",
+																											},
+																											&choiceExpr{
+																												pos: position{line: 36, col: 24, offset: 2329},
+																												alternatives: []any{
+																													&charClassMatcher{
+																														pos:        position{line: 37, col: 24, offset: 2385},
+																														val:        "[\"\\\\/bfnrt]",
+																														chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&seqExpr{
+																														pos: position{line: 38, col: 24, offset: 2420},
+																														exprs: []any{
+																															&litMatcher{
+																																pos:        position{line: 38, col: 24, offset: 2420},
+																																val:        "u",
+																																ignoreCase: false,
+																																want:       "\"u\"",
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 39, col: 24, offset: 2483},
+																																val:        "[0-9a-f]i",
+																																ranges:     []rune{'0', '9', 'a', 'f'},
+																																ignoreCase: true,
+																																inverted:   false,
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 39, col: 24, offset: 2483},
+																																val:        "[0-9a-f]i",
+																																ranges:     []rune{'0', '9', 'a', 'f'},
+																																ignoreCase: true,
+																																inverted:   false,
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 39, col: 24, offset: 2483},
+																																val:        "[0-9a-f]i",
+																																ranges:     []rune{'0', '9', 'a', 'f'},
+																																ignoreCase: true,
+																																inverted:   false,
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 39, col: 24, offset: 2483},
+																																val:        "[0-9a-f]i",
+																																ranges:     []rune{'0', '9', 'a', 'f'},
+																																ignoreCase: true,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																						&litMatcher{
+																							pos:        position{line: 33, col: 40, offset: 2139},
+																							val:        "\"",
+																							ignoreCase: false,
+																							want:       "\"\\\"\"",
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&zeroOrMoreExpr{
+																		pos: position{line: 44, col: 24, offset: 2941},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 44, col: 24, offset: 2941},
+																			val:        "[ \\t\\r\\n]",
+																			chars:      []rune{' ', '\t', '\r', '\n'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&litMatcher{
+																		pos:        position{line: 22, col: 59, offset: 1588},
+																		val:        "]",
+																		ignoreCase: false,
+																		want:       "\"]\"",
+																	},
 																},
 															},
 														},
@@ -457,9 +1153,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 37, col: 24, offset: 2532},
+									pos: position{line: 44, col: 24, offset: 2941},
 									expr: &charClassMatcher{
-										pos:        position{line: 37, col: 24, offset: 2532},
+										pos:        position{line: 44, col: 24, offset: 2941},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
@@ -467,61 +1163,67 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 16, col: 43, offset: 981},
+									pos:   position{line: 16, col: 38, offset: 976},
 									label: "op",
 									expr: &choiceExpr{
-										pos: position{line: 34, col: 26, offset: 2217},
+										pos: position{line: 41, col: 26, offset: 2619},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 34, col: 26, offset: 2217},
+												pos:        position{line: 41, col: 26, offset: 2619},
 												val:        ">=",
 												ignoreCase: false,
 												want:       "\">=\"",
 											},
 											&litMatcher{
-												pos:        position{line: 34, col: 33, offset: 2224},
+												pos:        position{line: 41, col: 33, offset: 2626},
 												val:        ">",
 												ignoreCase: false,
 												want:       "\">\"",
 											},
 											&litMatcher{
-												pos:        position{line: 34, col: 39, offset: 2230},
+												pos:        position{line: 41, col: 39, offset: 2632},
 												val:        "<=",
 												ignoreCase: false,
 												want:       "\"<=\"",
 											},
 											&litMatcher{
-												pos:        position{line: 34, col: 46, offset: 2237},
+												pos:        position{line: 41, col: 46, offset: 2639},
 												val:        "<",
 												ignoreCase: false,
 												want:       "\"<\"",
 											},
 											&litMatcher{
-												pos:        position{line: 34, col: 52, offset: 2243},
+												pos:        position{line: 41, col: 52, offset: 2645},
 												val:        "!:",
 												ignoreCase: false,
 												want:       "\"!:\"",
 											},
 											&litMatcher{
-												pos:        position{line: 34, col: 59, offset: 2250},
+												pos:        position{line: 41, col: 59, offset: 2652},
 												val:        "!=",
 												ignoreCase: false,
 												want:       "\"!=\"",
 											},
 											&charClassMatcher{
-												pos:        position{line: 34, col: 66, offset: 2257},
+												pos:        position{line: 41, col: 66, offset: 2659},
 												val:        "[:=~]",
 												chars:      []rune{':', '=', '~'},
 												ignoreCase: false,
 												inverted:   false,
 											},
+											&litMatcher{
+												pos:        position{line: 41, col: 84, offset: 2677},
+												val:        "@>",
+												ignoreCase: false,
+												want:       "\"@>\"",
+											},
 										},
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 37, col: 24, offset: 2532},
+									pos: position{line: 44, col: 24, offset: 2941},
 									expr: &charClassMatcher{
-										pos:        position{line: 37, col: 24, offset: 2532},
+										pos:        position{line: 44, col: 24, offset: 2941},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
@@ -529,27 +1231,27 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 16, col: 54, offset: 992},
+									pos:   position{line: 16, col: 49, offset: 987},
 									label: "value",
 									expr: &choiceExpr{
 										pos: position{line: 18, col: 24, offset: 1203},
 										alternatives: []any{
 											&actionExpr{
-												pos: position{line: 35, col: 24, offset: 2298},
-												run: (*parser).callonPrimary52,
+												pos: position{line: 42, col: 24, offset: 2707},
+												run: (*parser).callonPrimary185,
 												expr: &seqExpr{
-													pos: position{line: 35, col: 24, offset: 2298},
+													pos: position{line: 42, col: 24, offset: 2707},
 													exprs: []any{
 														&litMatcher{
-															pos:        position{line: 35, col: 24, offset: 2298},
+															pos:        position{line: 42, col: 24, offset: 2707},
 															val:        "[",
 															ignoreCase: false,
 															want:       "\"[\"",
 														},
 														&zeroOrMoreExpr{
-															pos: position{line: 37, col: 24, offset: 2532},
+															pos: position{line: 44, col: 24, offset: 2941},
 															expr: &charClassMatcher{
-																pos:        position{line: 37, col: 24, offset: 2532},
+																pos:        position{line: 44, col: 24, offset: 2941},
 																val:        "[ \\t\\r\\n]",
 																chars:      []rune{' ', '\t', '\r', '\n'},
 																ignoreCase: false,
@@ -557,46 +1259,46 @@ var g = &grammar{
 															},
 														},
 														&labeledExpr{
-															pos:   position{line: 35, col: 30, offset: 2304},
+															pos:   position{line: 42, col: 30, offset: 2713},
 															label: "values",
 															expr: &zeroOrOneExpr{
-																pos: position{line: 35, col: 37, offset: 2311},
+																pos: position{line: 42, col: 37, offset: 2720},
 																expr: &actionExpr{
-																	pos: position{line: 36, col: 24, offset: 2415},
-																	run: (*parser).callonPrimary59,
+																	pos: position{line: 43, col: 24, offset: 2824},
+																	run: (*parser).callonPrimary192,
 																	expr: &seqExpr{
-																		pos: position{line: 36, col: 24, offset: 2415},
+																		pos: position{line: 43, col: 24, offset: 2824},
 																		exprs: []any{
 																			&labeledExpr{
-																				pos:   position{line: 36, col: 24, offset: 2415},
+																				pos:   position{line: 43, col: 24, offset: 2824},
 																				label: "head",
 																				expr: &choiceExpr{
 																					pos: position{line: 19, col: 24, offset: 1277},
 																					alternatives: []any{
 																						&actionExpr{
-																							pos: position{line: 26, col: 24, offset: 1721},
-																							run: (*parser).callonPrimary63,
+																							pos: position{line: 33, col: 24, offset: 2123},
+																							run: (*parser).callonPrimary196,
 																							expr: &seqExpr{
-																								pos: position{line: 26, col: 24, offset: 1721},
+																								pos: position{line: 33, col: 24, offset: 2123},
 																								exprs: []any{
 																									&litMatcher{
-																										pos:        position{line: 26, col: 24, offset: 1721},
+																										pos:        position{line: 33, col: 24, offset: 2123},
 																										val:        "\"",
 																										ignoreCase: false,
 																										want:       "\"\\\"\"",
 																									},
 																									&zeroOrMoreExpr{
-																										pos: position{line: 27, col: 24, offset: 1824},
+																										pos: position{line: 34, col: 24, offset: 2226},
 																										expr: &choiceExpr{
-																											pos: position{line: 27, col: 26, offset: 1826},
+																											pos: position{line: 34, col: 26, offset: 2228},
 																											alternatives: []any{
 																												&seqExpr{
-																													pos: position{line: 27, col: 26, offset: 1826},
+																													pos: position{line: 34, col: 26, offset: 2228},
 																													exprs: []any{
 																														&notExpr{
-																															pos: position{line: 27, col: 26, offset: 1826},
+																															pos: position{line: 34, col: 26, offset: 2228},
 																															expr: &charClassMatcher{
-																																pos:        position{line: 28, col: 24, offset: 1889},
+																																pos:        position{line: 35, col: 24, offset: 2291},
 																																val:        "[\"\\\\\\x00-\\x1f]",
 																																chars:      []rune{'"', '\\'},
 																																ranges:     []rune{'\x00', '\x1f'},
@@ -605,61 +1307,61 @@ var g = &grammar{
 																															},
 																														},
 																														&anyMatcher{
-																															line: 27, col: 39, offset: 1839,
+																															line: 34, col: 39, offset: 2241,
 																														},
 																													},
 																												},
 																												&seqExpr{
-																													pos: position{line: 27, col: 43, offset: 1843},
+																													pos: position{line: 34, col: 43, offset: 2245},
 																													exprs: []any{
 																														&litMatcher{
-																															pos:        position{line: 27, col: 43, offset: 1843},
+																															pos:        position{line: 34, col: 43, offset: 2245},
 																															val:        "\\",
 																															ignoreCase: false,
 																															want:       "\"\\\\\"",
 																														},
 																														&choiceExpr{
-																															pos: position{line: 29, col: 24, offset: 1927},
+																															pos: position{line: 36, col: 24, offset: 2329},
 																															alternatives: []any{
 																																&charClassMatcher{
-																																	pos:        position{line: 30, col: 24, offset: 1983},
+																																	pos:        position{line: 37, col: 24, offset: 2385},
 																																	val:        "[\"\\\\/bfnrt]",
 																																	chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
 																																	ignoreCase: false,
 																																	inverted:   false,
 																																},
 																																&seqExpr{
-																																	pos: position{line: 31, col: 24, offset: 2018},
+																																	pos: position{line: 38, col: 24, offset: 2420},
 																																	exprs: []any{
 																																		&litMatcher{
-																																			pos:        position{line: 31, col: 24, offset: 2018},
+																																			pos:        position{line: 38, col: 24, offset: 2420},
 																																			val:        "u",
 																																			ignoreCase: false,
 																																			want:       "\"u\"",
 																																		},
 																																		&charClassMatcher{
-																																			pos:        position{line: 32, col: 24, offset: 2081},
+																																			pos:        position{line: 39, col: 24, offset: 2483},
 																																			val:        "[0-9a-f]i",
 																																			ranges:     []rune{'0', '9', 'a', 'f'},
 																																			ignoreCase: true,
 																																			inverted:   false,
 																																		},
 																																		&charClassMatcher{
-																																			pos:        position{line: 32, col: 24, offset: 2081},
+																																			pos:        position{line: 39, col: 24, offset: 2483},
 																																			val:        "[0-9a-f]i",
 																																			ranges:     []rune{'0', '9', 'a', 'f'},
 																																			ignoreCase: true,
 																																			inverted:   false,
 																																		},
 																																		&charClassMatcher{
-																																			pos:        position{line: 32, col: 24, offset: 2081},
+																																			pos:        position{line: 39, col: 24, offset: 2483},
 																																			val:        "[0-9a-f]i",
 																																			ranges:     []rune{'0', '9', 'a', 'f'},
 																																			ignoreCase: true,
 																																			inverted:   false,
 																																		},
 																																		&charClassMatcher{
-																																			pos:        position{line: 32, col: 24, offset: 2081},
+																																			pos:        position{line: 39, col: 24, offset: 2483},
 																																			val:        "[0-9a-f]i",
 																																			ranges:     []rune{'0', '9', 'a', 'f'},
 																																			ignoreCase: true,
@@ -675,7 +1377,7 @@ var g = &grammar{
 																										},
 																									},
 																									&litMatcher{
-																										pos:        position{line: 26, col: 40, offset: 1737},
+																										pos:        position{line: 33, col: 40, offset: 2139},
 																										val:        "\"",
 																										ignoreCase: false,
 																										want:       "\"\\\"\"",
@@ -684,43 +1386,43 @@ var g = &grammar{
 																							},
 																						},
 																						&actionExpr{
-																							pos: position{line: 23, col: 24, offset: 1560},
-																							run: (*parser).callonPrimary83,
+																							pos: position{line: 30, col: 24, offset: 1962},
+																							run: (*parser).callonPrimary216,
 																							expr: &seqExpr{
-																								pos: position{line: 23, col: 24, offset: 1560},
+																								pos: position{line: 30, col: 24, offset: 1962},
 																								exprs: []any{
 																									&zeroOrOneExpr{
-																										pos: position{line: 23, col: 24, offset: 1560},
+																										pos: position{line: 30, col: 24, offset: 1962},
 																										expr: &litMatcher{
-																											pos:        position{line: 23, col: 24, offset: 1560},
+																											pos:        position{line: 30, col: 24, offset: 1962},
 																											val:        "-",
 																											ignoreCase: false,
 																											want:       "\"-\"",
 																										},
 																									},
 																									&choiceExpr{
-																										pos: position{line: 22, col: 24, offset: 1497},
+																										pos: position{line: 29, col: 24, offset: 1899},
 																										alternatives: []any{
 																											&litMatcher{
-																												pos:        position{line: 22, col: 24, offset: 1497},
+																												pos:        position{line: 29, col: 24, offset: 1899},
 																												val:        "0",
 																												ignoreCase: false,
 																												want:       "\"0\"",
 																											},
 																											&seqExpr{
-																												pos: position{line: 22, col: 30, offset: 1503},
+																												pos: position{line: 29, col: 30, offset: 1905},
 																												exprs: []any{
 																													&charClassMatcher{
-																														pos:        position{line: 25, col: 24, offset: 1692},
+																														pos:        position{line: 32, col: 24, offset: 2094},
 																														val:        "[1-9]",
 																														ranges:     []rune{'1', '9'},
 																														ignoreCase: false,
 																														inverted:   false,
 																													},
 																													&zeroOrMoreExpr{
-																														pos: position{line: 22, col: 50, offset: 1523},
+																														pos: position{line: 29, col: 50, offset: 1925},
 																														expr: &charClassMatcher{
-																															pos:        position{line: 24, col: 24, offset: 1663},
+																															pos:        position{line: 31, col: 24, offset: 2065},
 																															val:        "[0-9]",
 																															ranges:     []rune{'0', '9'},
 																															ignoreCase: false,
@@ -732,20 +1434,20 @@ var g = &grammar{
 																										},
 																									},
 																									&zeroOrOneExpr{
-																										pos: position{line: 23, col: 37, offset: 1573},
+																										pos: position{line: 30, col: 37, offset: 1975},
 																										expr: &seqExpr{
-																											pos: position{line: 23, col: 39, offset: 1575},
+																											pos: position{line: 30, col: 39, offset: 1977},
 																											exprs: []any{
 																												&litMatcher{
-																													pos:        position{line: 23, col: 39, offset: 1575},
+																													pos:        position{line: 30, col: 39, offset: 1977},
 																													val:        ".",
 																													ignoreCase: false,
 																													want:       "\".\"",
 																												},
 																												&oneOrMoreExpr{
-																													pos: position{line: 23, col: 43, offset: 1579},
+																													pos: position{line: 30, col: 43, offset: 1981},
 																													expr: &charClassMatcher{
-																														pos:        position{line: 24, col: 24, offset: 1663},
+																														pos:        position{line: 31, col: 24, offset: 2065},
 																														val:        "[0-9]",
 																														ranges:     []rune{'0', '9'},
 																														ignoreCase: false,
@@ -759,19 +1461,19 @@ var g = &grammar{
 																							},
 																						},
 																						&actionExpr{
-																							pos: position{line: 33, col: 24, offset: 2114},
-																							run: (*parser).callonPrimary98,
+																							pos: position{line: 40, col: 24, offset: 2516},
+																							run: (*parser).callonPrimary231,
 																							expr: &choiceExpr{
-																								pos: position{line: 33, col: 25, offset: 2115},
+																								pos: position{line: 40, col: 25, offset: 2517},
 																								alternatives: []any{
 																									&litMatcher{
-																										pos:        position{line: 33, col: 25, offset: 2115},
+																										pos:        position{line: 40, col: 25, offset: 2517},
 																										val:        "true",
 																										ignoreCase: false,
 																										want:       "\"true\"",
 																									},
 																									&litMatcher{
-																										pos:        position{line: 33, col: 34, offset: 2124},
+																										pos:        position{line: 40, col: 34, offset: 2526},
 																										val:        "false",
 																										ignoreCase: false,
 																										want:       "\"false\"",
@@ -781,12 +1483,12 @@ var g = &grammar{
 																						},
 																						&actionExpr{
 																							pos: position{line: 20, col: 24, offset: 1339},
-																							run: (*parser).callonPrimary102,
+																							run: (*parser).callonPrimary235,
 																							expr: &seqExpr{
 																								pos: position{line: 20, col: 24, offset: 1339},
 																								exprs: []any{
 																									&charClassMatcher{
-																										pos:        position{line: 21, col: 24, offset: 1451},
+																										pos:        position{line: 28, col: 24, offset: 1853},
 																										val:        "[_a-zA-Z]",
 																										chars:      []rune{'_'},
 																										ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -794,9 +1496,9 @@ var g = &grammar{
 																										inverted:   false,
 																									},
 																									&zeroOrMoreExpr{
-																										pos: position{line: 21, col: 33, offset: 1460},
+																										pos: position{line: 28, col: 33, offset: 1862},
 																										expr: &charClassMatcher{
-																											pos:        position{line: 21, col: 33, offset: 1460},
+																											pos:        position{line: 28, col: 33, offset: 1862},
 																											val:        "[_a-zA-Z0-9]",
 																											chars:      []rune{'_'},
 																											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -816,7 +1518,7 @@ var g = &grammar{
 																													want:       "\".\"",
 																												},
 																												&charClassMatcher{
-																													pos:        position{line: 21, col: 24, offset: 1451},
+																													pos:        position{line: 28, col: 24, offset: 1853},
 																													val:        "[_a-zA-Z]",
 																													chars:      []rune{'_'},
 																													ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -824,9 +1526,9 @@ var g = &grammar{
 																													inverted:   false,
 																												},
 																												&zeroOrMoreExpr{
-																													pos: position{line: 21, col: 33, offset: 1460},
+																													pos: position{line: 28, col: 33, offset: 1862},
 																													expr: &charClassMatcher{
-																														pos:        position{line: 21, col: 33, offset: 1460},
+																														pos:        position{line: 28, col: 33, offset: 1862},
 																														val:        "[_a-zA-Z0-9]",
 																														chars:      []rune{'_'},
 																														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -844,17 +1546,17 @@ var g = &grammar{
 																				},
 																			},
 																			&labeledExpr{
-																				pos:   position{line: 36, col: 40, offset: 2431},
+																				pos:   position{line: 43, col: 40, offset: 2840},
 																				label: "tail",
 																				expr: &zeroOrMoreExpr{
-																					pos: position{line: 36, col: 45, offset: 2436},
+																					pos: position{line: 43, col: 45, offset: 2845},
 																					expr: &seqExpr{
-																						pos: position{line: 36, col: 46, offset: 2437},
+																						pos: position{line: 43, col: 46, offset: 2846},
 																						exprs: []any{
 																							&zeroOrMoreExpr{
-																								pos: position{line: 37, col: 24, offset: 2532},
+																								pos: position{line: 44, col: 24, offset: 2941},
 																								expr: &charClassMatcher{
-																									pos:        position{line: 37, col: 24, offset: 2532},
+																									pos:        position{line: 44, col: 24, offset: 2941},
 																									val:        "[ \\t\\r\\n]",
 																									chars:      []rune{' ', '\t', '\r', '\n'},
 																									ignoreCase: false,
@@ -862,15 +1564,15 @@ var g = &grammar{
 																								},
 																							},
 																							&litMatcher{
-																								pos:        position{line: 36, col: 48, offset: 2439},
+																								pos:        position{line: 43, col: 48, offset: 2848},
 																								val:        ",",
 																								ignoreCase: false,
 																								want:       "\",\"",
 																							},
 																							&zeroOrMoreExpr{
-																								pos: position{line: 37, col: 24, offset: 2532},
+																								pos: position{line: 44, col: 24, offset: 2941},
 																								expr: &charClassMatcher{
-																									pos:        position{line: 37, col: 24, offset: 2532},
+																									pos:        position{line: 44, col: 24, offset: 2941},
 																									val:        "[ \\t\\r\\n]",
 																									chars:      []rune{' ', '\t', '\r', '\n'},
 																									ignoreCase: false,
@@ -881,29 +1583,29 @@ var g = &grammar{
 																								pos: position{line: 19, col: 24, offset: 1277},
 																								alternatives: []any{
 																									&actionExpr{
-																										pos: position{line: 26, col: 24, offset: 1721},
-																										run: (*parser).callonPrimary122,
+																										pos: position{line: 33, col: 24, offset: 2123},
+																										run: (*parser).callonPrimary255,
 																										expr: &seqExpr{
-																											pos: position{line: 26, col: 24, offset: 1721},
+																											pos: position{line: 33, col: 24, offset: 2123},
 																											exprs: []any{
 																												&litMatcher{
-																													pos:        position{line: 26, col: 24, offset: 1721},
+																													pos:        position{line: 33, col: 24, offset: 2123},
 																													val:        "\"",
 																													ignoreCase: false,
 																													want:       "\"\\\"\"",
 																												},
 																												&zeroOrMoreExpr{
-																													pos: position{line: 27, col: 24, offset: 1824},
+																													pos: position{line: 34, col: 24, offset: 2226},
 																													expr: &choiceExpr{
-																														pos: position{line: 27, col: 26, offset: 1826},
+																														pos: position{line: 34, col: 26, offset: 2228},
 																														alternatives: []any{
 																															&seqExpr{
-																																pos: position{line: 27, col: 26, offset: 1826},
+																																pos: position{line: 34, col: 26, offset: 2228},
 																																exprs: []any{
 																																	&notExpr{
-																																		pos: position{line: 27, col: 26, offset: 1826},
+																																		pos: position{line: 34, col: 26, offset: 2228},
 																																		expr: &charClassMatcher{
-																																			pos:        position{line: 28, col: 24, offset: 1889},
+																																			pos:        position{line: 35, col: 24, offset: 2291},
 																																			val:        "[\"\\\\\\x00-\\x1f]",
 																																			chars:      []rune{'"', '\\'},
 																																			ranges:     []rune{'\x00', '\x1f'},
@@ -912,61 +1614,61 @@ var g = &grammar{
 																																		},
 																																	},
 																																	&anyMatcher{
-																																		line: 27, col: 39, offset: 1839,
+																																		line: 34, col: 39, offset: 2241,
 																																	},
 																																},
 																															},
 																															&seqExpr{
-																																pos: position{line: 27, col: 43, offset: 1843},
+																																pos: position{line: 34, col: 43, offset: 2245},
 																																exprs: []any{
 																																	&litMatcher{
-																																		pos:        position{line: 27, col: 43, offset: 1843},
+																																		pos:        position{line: 34, col: 43, offset: 2245},
 																																		val:        "\\",
 																																		ignoreCase: false,
 																																		want:       "\"\\\\\"",
 																																	},
 																																	&choiceExpr{
-																																		pos: position{line: 29, col: 24, offset: 1927},
+																																		pos: position{line: 36, col: 24, offset: 2329},
 																																		alternatives: []any{
 																																			&charClassMatcher{
-																																				pos:        position{line: 30, col: 24, offset: 1983},
+																																				pos:        position{line: 37, col: 24, offset: 2385},
 																																				val:        "[\"\\\\/bfnrt]",
 																																				chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
 																																				ignoreCase: false,
 																																				inverted:   false,
 																																			},
 																																			&seqExpr{
-																																				pos: position{line: 31, col: 24, offset: 2018},
+																																				pos: position{line: 38, col: 24, offset: 2420},
 																																				exprs: []any{
 																																					&litMatcher{
-																																						pos:        position{line: 31, col: 24, offset: 2018},
+																																						pos:        position{line: 38, col: 24, offset: 2420},
 																																						val:        "u",
 																																						ignoreCase: false,
 																																						want:       "\"u\"",
 																																					},
 																																					&charClassMatcher{
-																																						pos:        position{line: 32, col: 24, offset: 2081},
+																																						pos:        position{line: 39, col: 24, offset: 2483},
 																																						val:        "[0-9a-f]i",
 																																						ranges:     []rune{'0', '9', 'a', 'f'},
 																																						ignoreCase: true,
 																																						inverted:   false,
 																																					},
 																																					&charClassMatcher{
-																																						pos:        position{line: 32, col: 24, offset: 2081},
+																																						pos:        position{line: 39, col: 24, offset: 2483},
 																																						val:        "[0-9a-f]i",
 																																						ranges:     []rune{'0', '9', 'a', 'f'},
 																																						ignoreCase: true,
 																																						inverted:   false,
 																																					},
 																																					&charClassMatcher{
-																																						pos:        position{line: 32, col: 24, offset: 2081},
+																																						pos:        position{line: 39, col: 24, offset: 2483},
 																																						val:        "[0-9a-f]i",
 																																						ranges:     []rune{'0', '9', 'a', 'f'},
 																																						ignoreCase: true,
 																																						inverted:   false,
 																																					},
 																																					&charClassMatcher{
-																																						pos:        position{line: 32, col: 24, offset: 2081},
+																																						pos:        position{line: 39, col: 24, offset: 2483},
 																																						val:        "[0-9a-f]i",
 																																						ranges:     []rune{'0', '9', 'a', 'f'},
 																																						ignoreCase: true,
@@ -982,7 +1684,7 @@ var g = &grammar{
 																													},
 																												},
 																												&litMatcher{
-																													pos:        position{line: 26, col: 40, offset: 1737},
+																													pos:        position{line: 33, col: 40, offset: 2139},
 																													val:        "\"",
 																													ignoreCase: false,
 																													want:       "\"\\\"\"",
@@ -991,43 +1693,43 @@ var g = &grammar{
 																										},
 																									},
 																									&actionExpr{
-																										pos: position{line: 23, col: 24, offset: 1560},
-																										run: (*parser).callonPrimary142,
+																										pos: position{line: 30, col: 24, offset: 1962},
+																										run: (*parser).callonPrimary275,
 																										expr: &seqExpr{
-																											pos: position{line: 23, col: 24, offset: 1560},
+																											pos: position{line: 30, col: 24, offset: 1962},
 																											exprs: []any{
 																												&zeroOrOneExpr{
-																													pos: position{line: 23, col: 24, offset: 1560},
+																													pos: position{line: 30, col: 24, offset: 1962},
 																													expr: &litMatcher{
-																														pos:        position{line: 23, col: 24, offset: 1560},
+																														pos:        position{line: 30, col: 24, offset: 1962},
 																														val:        "-",
 																														ignoreCase: false,
 																														want:       "\"-\"",
 																													},
 																												},
 																												&choiceExpr{
-																													pos: position{line: 22, col: 24, offset: 1497},
+																													pos: position{line: 29, col: 24, offset: 1899},
 																													alternatives: []any{
 																														&litMatcher{
-																															pos:        position{line: 22, col: 24, offset: 1497},
+																															pos:        position{line: 29, col: 24, offset: 1899},
 																															val:        "0",
 																															ignoreCase: false,
 																															want:       "\"0\"",
 																														},
 																														&seqExpr{
-																															pos: position{line: 22, col: 30, offset: 1503},
+																															pos: position{line: 29, col: 30, offset: 1905},
 																															exprs: []any{
 																																&charClassMatcher{
-																																	pos:        position{line: 25, col: 24, offset: 1692},
+																																	pos:        position{line: 32, col: 24, offset: 2094},
 																																	val:        "[1-9]",
 																																	ranges:     []rune{'1', '9'},
 																																	ignoreCase: false,
 																																	inverted:   false,
 																																},
 																																&zeroOrMoreExpr{
-																																	pos: position{line: 22, col: 50, offset: 1523},
+																																	pos: position{line: 29, col: 50, offset: 1925},
 																																	expr: &charClassMatcher{
-																																		pos:        position{line: 24, col: 24, offset: 1663},
+																																		pos:        position{line: 31, col: 24, offset: 2065},
 																																		val:        "[0-9]",
 																																		ranges:     []rune{'0', '9'},
 																																		ignoreCase: false,
@@ -1039,20 +1741,20 @@ var g = &grammar{
 																													},
 																												},
 																												&zeroOrOneExpr{
-																													pos: position{line: 23, col: 37, offset: 1573},
+																													pos: position{line: 30, col: 37, offset: 1975},
 																													expr: &seqExpr{
-																														pos: position{line: 23, col: 39, offset: 1575},
+																														pos: position{line: 30, col: 39, offset: 1977},
 																														exprs: []any{
 																															&litMatcher{
-																																pos:        position{line: 23, col: 39, offset: 1575},
+																																pos:        position{line: 30, col: 39, offset: 1977},
 																																val:        ".",
 																																ignoreCase: false,
 																																want:       "\".\"",
 																															},
 																															&oneOrMoreExpr{
-																																pos: position{line: 23, col: 43, offset: 1579},
+																																pos: position{line: 30, col: 43, offset: 1981},
 																																expr: &charClassMatcher{
-																																	pos:        position{line: 24, col: 24, offset: 1663},
+																																	pos:        position{line: 31, col: 24, offset: 2065},
 																																	val:        "[0-9]",
 																																	ranges:     []rune{'0', '9'},
 																																	ignoreCase: false,
@@ -1066,19 +1768,19 @@ var g = &grammar{
 																										},
 																									},
 																									&actionExpr{
-																										pos: position{line: 33, col: 24, offset: 2114},
-																										run: (*parser).callonPrimary157,
+																										pos: position{line: 40, col: 24, offset: 2516},
+																										run: (*parser).callonPrimary290,
 																										expr: &choiceExpr{
-																											pos: position{line: 33, col: 25, offset: 2115},
+																											pos: position{line: 40, col: 25, offset: 2517},
 																											alternatives: []any{
 																												&litMatcher{
-																													pos:        position{line: 33, col: 25, offset: 2115},
+																													pos:        position{line: 40, col: 25, offset: 2517},
 																													val:        "true",
 																													ignoreCase: false,
 																													want:       "\"true\"",
 																												},
 																												&litMatcher{
-																													pos:        position{line: 33, col: 34, offset: 2124},
+																													pos:        position{line: 40, col: 34, offset: 2526},
 																													val:        "false",
 																													ignoreCase: false,
 																													want:       "\"false\"",
@@ -1088,12 +1790,12 @@ var g = &grammar{
 																									},
 																									&actionExpr{
 																										pos: position{line: 20, col: 24, offset: 1339},
-																										run: (*parser).callonPrimary161,
+																										run: (*parser).callonPrimary294,
 																										expr: &seqExpr{
 																											pos: position{line: 20, col: 24, offset: 1339},
 																											exprs: []any{
 																												&charClassMatcher{
-																													pos:        position{line: 21, col: 24, offset: 1451},
+																													pos:        position{line: 28, col: 24, offset: 1853},
 																													val:        "[_a-zA-Z]",
 																													chars:      []rune{'_'},
 																													ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -1101,9 +1803,9 @@ var g = &grammar{
 																													inverted:   false,
 																												},
 																												&zeroOrMoreExpr{
-																													pos: position{line: 21, col: 33, offset: 1460},
+																													pos: position{line: 28, col: 33, offset: 1862},
 																													expr: &charClassMatcher{
-																														pos:        position{line: 21, col: 33, offset: 1460},
+																														pos:        position{line: 28, col: 33, offset: 1862},
 																														val:        "[_a-zA-Z0-9]",
 																														chars:      []rune{'_'},
 																														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -1123,7 +1825,7 @@ var g = &grammar{
 																																want:       "\".\"",
 																															},
 																															&charClassMatcher{
-																																pos:        position{line: 21, col: 24, offset: 1451},
+																																pos:        position{line: 28, col: 24, offset: 1853},
 																																val:        "[_a-zA-Z]",
 																																chars:      []rune{'_'},
 																																ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -1131,9 +1833,9 @@ var g = &grammar{
 																																inverted:   false,
 																															},
 																															&zeroOrMoreExpr{
-																																pos: position{line: 21, col: 33, offset: 1460},
+																																pos: position{line: 28, col: 33, offset: 1862},
 																																expr: &charClassMatcher{
-																																	pos:        position{line: 21, col: 33, offset: 1460},
+																																	pos:        position{line: 28, col: 33, offset: 1862},
 																																	val:        "[_a-zA-Z0-9]",
 																																	chars:      []rune{'_'},
 																																	ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -1159,9 +1861,9 @@ var g = &grammar{
 															},
 														},
 														&zeroOrMoreExpr{
-															pos: position{line: 37, col: 24, offset: 2532},
+															pos: position{line: 44, col: 24, offset: 2941},
 															expr: &charClassMatcher{
-																pos:        position{line: 37, col: 24, offset: 2532},
+																pos:        position{line: 44, col: 24, offset: 2941},
 																val:        "[ \\t\\r\\n]",
 																chars:      []rune{' ', '\t', '\r', '\n'},
 																ignoreCase: false,
@@ -1169,7 +1871,7 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 35, col: 54, offset: 2328},
+															pos:        position{line: 42, col: 54, offset: 2737},
 															val:        "]",
 															ignoreCase: false,
 															want:       "\"]\"",
@@ -1178,29 +1880,29 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 26, col: 24, offset: 1721},
-												run: (*parser).callonPrimary175,
+												pos: position{line: 33, col: 24, offset: 2123},
+												run: (*parser).callonPrimary308,
 												expr: &seqExpr{
-													pos: position{line: 26, col: 24, offset: 1721},
+													pos: position{line: 33, col: 24, offset: 2123},
 													exprs: []any{
 														&litMatcher{
-															pos:        position{line: 26, col: 24, offset: 1721},
+															pos:        position{line: 33, col: 24, offset: 2123},
 															val:        "\"",
 															ignoreCase: false,
 															want:       "\"\\\"\"",
 														},
 														&zeroOrMoreExpr{
-															pos: position{line: 27, col: 24, offset: 1824},
+															pos: position{line: 34, col: 24, offset: 2226},
 															expr: &choiceExpr{
-																pos: position{line: 27, col: 26, offset: 1826},
+																pos: position{line: 34, col: 26, offset: 2228},
 																alternatives: []any{
 																	&seqExpr{
-																		pos: position{line: 27, col: 26, offset: 1826},
+																		pos: position{line: 34, col: 26, offset: 2228},
 																		exprs: []any{
 																			&notExpr{
-																				pos: position{line: 27, col: 26, offset: 1826},
+																				pos: position{line: 34, col: 26, offset: 2228},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 28, col: 24, offset: 1889},
+																					pos:        position{line: 35, col: 24, offset: 2291},
 																					val:        "[\"\\\\\\x00-\\x1f]",
 																					chars:      []rune{'"', '\\'},
 																					ranges:     []rune{'\x00', '\x1f'},
@@ -1209,61 +1911,61 @@ var g = &grammar{
 																				},
 																			},
 																			&anyMatcher{
-																				line: 27, col: 39, offset: 1839,
+																				line: 34, col: 39, offset: 2241,
 																			},
 																		},
 																	},
 																	&seqExpr{
-																		pos: position{line: 27, col: 43, offset: 1843},
+																		pos: position{line: 34, col: 43, offset: 2245},
 																		exprs: []any{
 																			&litMatcher{
-																				pos:        position{line: 27, col: 43, offset: 1843},
+																				pos:        position{line: 34, col: 43, offset: 2245},
 																				val:        "\\",
 																				ignoreCase: false,
 																				want:       "\"\\\\\"",
 																			},
 																			&choiceExpr{
-																				pos: position{line: 29, col: 24, offset: 1927},
+																				pos: position{line: 36, col: 24, offset: 2329},
 																				alternatives: []any{
 																					&charClassMatcher{
-																						pos:        position{line: 30, col: 24, offset: 1983},
+																						pos:        position{line: 37, col: 24, offset: 2385},
 																						val:        "[\"\\\\/bfnrt]",
 																						chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
 																						ignoreCase: false,
 																						inverted:   false,
 																					},
 																					&seqExpr{
-																						pos: position{line: 31, col: 24, offset: 2018},
+																						pos: position{line: 38, col: 24, offset: 2420},
 																						exprs: []any{
 																							&litMatcher{
-																								pos:        position{line: 31, col: 24, offset: 2018},
+																								pos:        position{line: 38, col: 24, offset: 2420},
 																								val:        "u",
 																								ignoreCase: false,
 																								want:       "\"u\"",
 																							},
 																							&charClassMatcher{
-																								pos:        position{line: 32, col: 24, offset: 2081},
+																								pos:        position{line: 39, col: 24, offset: 2483},
 																								val:        "[0-9a-f]i",
 																								ranges:     []rune{'0', '9', 'a', 'f'},
 																								ignoreCase: true,
 																								inverted:   false,
 																							},
 																							&charClassMatcher{
-																								pos:        position{line: 32, col: 24, offset: 2081},
+																								pos:        position{line: 39, col: 24, offset: 2483},
 																								val:        "[0-9a-f]i",
 																								ranges:     []rune{'0', '9', 'a', 'f'},
 																								ignoreCase: true,
 																								inverted:   false,
 																							},
 																							&charClassMatcher{
-																								pos:        position{line: 32, col: 24, offset: 2081},
+																								pos:        position{line: 39, col: 24, offset: 2483},
 																								val:        "[0-9a-f]i",
 																								ranges:     []rune{'0', '9', 'a', 'f'},
 																								ignoreCase: true,
 																								inverted:   false,
 																							},
 																							&charClassMatcher{
-																								pos:        position{line: 32, col: 24, offset: 2081},
+																								pos:        position{line: 39, col: 24, offset: 2483},
 																								val:        "[0-9a-f]i",
 																								ranges:     []rune{'0', '9', 'a', 'f'},
 																								ignoreCase: true,
@@ -1279,7 +1981,7 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 26, col: 40, offset: 1737},
+															pos:        position{line: 33, col: 40, offset: 2139},
 															val:        "\"",
 															ignoreCase: false,
 															want:       "\"\\\"\"",
@@ -1288,43 +1990,43 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 23, col: 24, offset: 1560},
-												run: (*parser).callonPrimary195,
+												pos: position{line: 30, col: 24, offset: 1962},
+												run: (*parser).callonPrimary328,
 												expr: &seqExpr{
-													pos: position{line: 23, col: 24, offset: 1560},
+													pos: position{line: 30, col: 24, offset: 1962},
 													exprs: []any{
 														&zeroOrOneExpr{
-															pos: position{line: 23, col: 24, offset: 1560},
+															pos: position{line: 30, col: 24, offset: 1962},
 															expr: &litMatcher{
-																pos:        position{line: 23, col: 24, offset: 1560},
+																pos:        position{line: 30, col: 24, offset: 1962},
 																val:        "-",
 																ignoreCase: false,
 																want:       "\"-\"",
 															},
 														},
 														&choiceExpr{
-															pos: position{line: 22, col: 24, offset: 1497},
+															pos: position{line: 29, col: 24, offset: 1899},
 															alternatives: []any{
 																&litMatcher{
-																	pos:        position{line: 22, col: 24, offset: 1497},
+																	pos:        position{line: 29, col: 24, offset: 1899},
 																	val:        "0",
 																	ignoreCase: false,
 																	want:       "\"0\"",
 																},
 																&seqExpr{
-																	pos: position{line: 22, col: 30, offset: 1503},
+																	pos: position{line: 29, col: 30, offset: 1905},
 																	exprs: []any{
 																		&charClassMatcher{
-																			pos:        position{line: 25, col: 24, offset: 1692},
+																			pos:        position{line: 32, col: 24, offset: 2094},
 																			val:        "[1-9]",
 																			ranges:     []rune{'1', '9'},
 																			ignoreCase: false,
 																			inverted:   false,
 																		},
 																		&zeroOrMoreExpr{
-																			pos: position{line: 22, col: 50, offset: 1523},
+																			pos: position{line: 29, col: 50, offset: 1925},
 																			expr: &charClassMatcher{
-																				pos:        position{line: 24, col: 24, offset: 1663},
+																				pos:        position{line: 31, col: 24, offset: 2065},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
@@ -1336,20 +2038,20 @@ var g = &grammar{
 															},
 														},
 														&zeroOrOneExpr{
-															pos: position{line: 23, col: 37, offset: 1573},
+															pos: position{line: 30, col: 37, offset: 1975},
 															expr: &seqExpr{
-																pos: position{line: 23, col: 39, offset: 1575},
+																pos: position{line: 30, col: 39, offset: 1977},
 																exprs: []any{
 																	&litMatcher{
-																		pos:        position{line: 23, col: 39, offset: 1575},
+																		pos:        position{line: 30, col: 39, offset: 1977},
 																		val:        ".",
 																		ignoreCase: false,
 																		want:       "\".\"",
 																	},
 																	&oneOrMoreExpr{
-																		pos: position{line: 23, col: 43, offset: 1579},
+																		pos: position{line: 30, col: 43, offset: 1981},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 24, col: 24, offset: 1663},
+																			pos:        position{line: 31, col: 24, offset: 2065},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -1363,19 +2065,19 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 33, col: 24, offset: 2114},
-												run: (*parser).callonPrimary210,
+												pos: position{line: 40, col: 24, offset: 2516},
+												run: (*parser).callonPrimary343,
 												expr: &choiceExpr{
-													pos: position{line: 33, col: 25, offset: 2115},
+													pos: position{line: 40, col: 25, offset: 2517},
 													alternatives: []any{
 														&litMatcher{
-															pos:        position{line: 33, col: 25, offset: 2115},
+															pos:        position{line: 40, col: 25, offset: 2517},
 															val:        "true",
 															ignoreCase: false,
 															want:       "\"true\"",
 														},
 														&litMatcher{
-															pos:        position{line: 33, col: 34, offset: 2124},
+															pos:        position{line: 40, col: 34, offset: 2526},
 															val:        "false",
 															ignoreCase: false,
 															want:       "\"false\"",
@@ -1385,12 +2087,12 @@ var g = &grammar{
 											},
 											&actionExpr{
 												pos: position{line: 20, col: 24, offset: 1339},
-												run: (*parser).callonPrimary214,
+												run: (*parser).callonPrimary347,
 												expr: &seqExpr{
 													pos: position{line: 20, col: 24, offset: 1339},
 													exprs: []any{
 														&charClassMatcher{
-															pos:        position{line: 21, col: 24, offset: 1451},
+															pos:        position{line: 28, col: 24, offset: 1853},
 															val:        "[_a-zA-Z]",
 															chars:      []rune{'_'},
 															ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -1398,9 +2100,9 @@ var g = &grammar{
 															inverted:   false,
 														},
 														&zeroOrMoreExpr{
-															pos: position{line: 21, col: 33, offset: 1460},
+															pos: position{line: 28, col: 33, offset: 1862},
 															expr: &charClassMatcher{
-																pos:        position{line: 21, col: 33, offset: 1460},
+																pos:        position{line: 28, col: 33, offset: 1862},
 																val:        "[_a-zA-Z0-9]",
 																chars:      []rune{'_'},
 																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -1420,7 +2122,7 @@ var g = &grammar{
 																		want:       "\".\"",
 																	},
 																	&charClassMatcher{
-																		pos:        position{line: 21, col: 24, offset: 1451},
+																		pos:        position{line: 28, col: 24, offset: 1853},
 																		val:        "[_a-zA-Z]",
 																		chars:      []rune{'_'},
 																		ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -1428,9 +2130,9 @@ var g = &grammar{
 																		inverted:   false,
 																	},
 																	&zeroOrMoreExpr{
-																		pos: position{line: 21, col: 33, offset: 1460},
+																		pos: position{line: 28, col: 33, offset: 1862},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 21, col: 33, offset: 1460},
+																			pos:        position{line: 28, col: 33, offset: 1862},
 																			val:        "[_a-zA-Z0-9]",
 																			chars:      []rune{'_'},
 																			ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -1452,63 +2154,411 @@ var g = &grammar{
 					},
 					&actionExpr{
 						pos: position{line: 17, col: 24, offset: 1089},
-						run: (*parser).callonPrimary225,
+						run: (*parser).callonPrimary358,
 						expr: &labeledExpr{
 							pos:   position{line: 17, col: 24, offset: 1089},
 							label: "field",
 							expr: &actionExpr{
-								pos: position{line: 20, col: 24, offset: 1339},
-								run: (*parser).callonPrimary227,
+								pos: position{line: 21, col: 24, offset: 1451},
+								run: (*parser).callonPrimary360,
 								expr: &seqExpr{
-									pos: position{line: 20, col: 24, offset: 1339},
+									pos: position{line: 21, col: 24, offset: 1451},
 									exprs: []any{
-										&charClassMatcher{
-											pos:        position{line: 21, col: 24, offset: 1451},
-											val:        "[_a-zA-Z]",
-											chars:      []rune{'_'},
-											ranges:     []rune{'a', 'z', 'A', 'Z'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 21, col: 33, offset: 1460},
-											expr: &charClassMatcher{
-												pos:        position{line: 21, col: 33, offset: 1460},
-												val:        "[_a-zA-Z0-9]",
-												chars:      []rune{'_'},
-												ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
-												ignoreCase: false,
-												inverted:   false,
+										&choiceExpr{
+											pos: position{line: 24, col: 24, offset: 1660},
+											alternatives: []any{
+												&seqExpr{
+													pos: position{line: 28, col: 24, offset: 1853},
+													exprs: []any{
+														&charClassMatcher{
+															pos:        position{line: 28, col: 24, offset: 1853},
+															val:        "[_a-zA-Z]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'a', 'z', 'A', 'Z'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 28, col: 33, offset: 1862},
+															expr: &charClassMatcher{
+																pos:        position{line: 28, col: 33, offset: 1862},
+																val:        "[_a-zA-Z0-9]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+												},
+												&seqExpr{
+													pos: position{line: 25, col: 24, offset: 1709},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 25, col: 24, offset: 1709},
+															val:        "`",
+															ignoreCase: false,
+															want:       "\"`\"",
+														},
+														&oneOrMoreExpr{
+															pos: position{line: 25, col: 28, offset: 1713},
+															expr: &choiceExpr{
+																pos: position{line: 25, col: 30, offset: 1715},
+																alternatives: []any{
+																	&seqExpr{
+																		pos: position{line: 25, col: 30, offset: 1715},
+																		exprs: []any{
+																			&notExpr{
+																				pos: position{line: 25, col: 30, offset: 1715},
+																				expr: &charClassMatcher{
+																					pos:        position{line: 26, col: 24, offset: 1786},
+																					val:        "[`\\\\\\x00-\\x1f]",
+																					chars:      []rune{'`', '\\'},
+																					ranges:     []rune{'\x00', '\x1f'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																			},
+																			&anyMatcher{
+																				line: 25, col: 49, offset: 1734,
+																			},
+																		},
+																	},
+																	&seqExpr{
+																		pos: position{line: 25, col: 53, offset: 1738},
+																		exprs: []any{
+																			&litMatcher{
+																				pos:        position{line: 25, col: 53, offset: 1738},
+																				val:        "\\",
+																				ignoreCase: false,
+																				want:       "\"\\\\\"",
+																			},
+																			&charClassMatcher{
+																				pos:        position{line: 27, col: 24, offset: 1824},
+																				val:        "[`\\\\]",
+																				chars:      []rune{'`', '\\'},
+																				ignoreCase: false,
+																				inverted:   false,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 25, col: 74, offset: 1759},
+															val:        "`",
+															ignoreCase: false,
+															want:       "\"`\"",
+														},
+													},
+												},
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 20, col: 37, offset: 1352},
-											expr: &seqExpr{
-												pos: position{line: 20, col: 38, offset: 1353},
-												exprs: []any{
-													&litMatcher{
-														pos:        position{line: 20, col: 38, offset: 1353},
-														val:        ".",
-														ignoreCase: false,
-														want:       "\".\"",
-													},
-													&charClassMatcher{
-														pos:        position{line: 21, col: 24, offset: 1451},
-														val:        "[_a-zA-Z]",
-														chars:      []rune{'_'},
-														ranges:     []rune{'a', 'z', 'A', 'Z'},
-														ignoreCase: false,
-														inverted:   false,
+											pos: position{line: 21, col: 34, offset: 1461},
+											expr: &choiceExpr{
+												pos: position{line: 22, col: 24, offset: 1553},
+												alternatives: []any{
+													&seqExpr{
+														pos: position{line: 22, col: 24, offset: 1553},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 22, col: 24, offset: 1553},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&choiceExpr{
+																pos: position{line: 24, col: 24, offset: 1660},
+																alternatives: []any{
+																	&seqExpr{
+																		pos: position{line: 28, col: 24, offset: 1853},
+																		exprs: []any{
+																			&charClassMatcher{
+																				pos:        position{line: 28, col: 24, offset: 1853},
+																				val:        "[_a-zA-Z]",
+																				chars:      []rune{'_'},
+																				ranges:     []rune{'a', 'z', 'A', 'Z'},
+																				ignoreCase: false,
+																				inverted:   false,
+																			},
+																			&zeroOrMoreExpr{
+																				pos: position{line: 28, col: 33, offset: 1862},
+																				expr: &charClassMatcher{
+																					pos:        position{line: 28, col: 33, offset: 1862},
+																					val:        "[_a-zA-Z0-9]",
+																					chars:      []rune{'_'},
+																					ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																			},
+																		},
+																	},
+																	&seqExpr{
+																		pos: position{line: 25, col: 24, offset: 1709},
+																		exprs: []any{
+																			&litMatcher{
+																				pos:        position{line: 25, col: 24, offset: 1709},
+																				val:        "`",
+																				ignoreCase: false,
+																				want:       "\"`\"",
+																			},
+																			&oneOrMoreExpr{
+																				pos: position{line: 25, col: 28, offset: 1713},
+																				expr: &choiceExpr{
+																					pos: position{line: 25, col: 30, offset: 1715},
+																					alternatives: []any{
+																						&seqExpr{
+																							pos: position{line: 25, col: 30, offset: 1715},
+																							exprs: []any{
+																								&notExpr{
+																									pos: position{line: 25, col: 30, offset: 1715},
+																									expr: &charClassMatcher{
+																										pos:        position{line: 26, col: 24, offset: 1786},
+																										val:        "[`\\\\\\x00-\\x1f]",
+																										chars:      []rune{'`', '\\'},
+																										ranges:     []rune{'\x00', '\x1f'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&anyMatcher{
+																									line: 25, col: 49, offset: 1734,
+																								},
+																							},
+																						},
+																						&seqExpr{
+																							pos: position{line: 25, col: 53, offset: 1738},
+																							exprs: []any{
+																								&litMatcher{
+																									pos:        position{line: 25, col: 53, offset: 1738},
+																									val:        "\\",
+																									ignoreCase: false,
+																									want:       "\"\\\\\"",
+																								},
+																								&charClassMatcher{
+																									pos:        position{line: 27, col: 24, offset: 1824},
+																									val:        "[`\\\\]",
+																									chars:      []rune{'`', '\\'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																			&litMatcher{
+																				pos:        position{line: 25, col: 74, offset: 1759},
+																				val:        "`",
+																				ignoreCase: false,
+																				want:       "\"`\"",
+																			},
+																		},
+																	},
+																},
+															},
+														},
 													},
-													&zeroOrMoreExpr{
-														pos: position{line: 21, col: 33, offset: 1460},
-														expr: &charClassMatcher{
-															pos:        position{line: 21, col: 33, offset: 1460},
-															val:        "[_a-zA-Z0-9]",
-															chars:      []rune{'_'},
-															ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
-															ignoreCase: false,
-															inverted:   false,
+													&seqExpr{
+														pos: position{line: 22, col: 40, offset: 1569},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 22, col: 40, offset: 1569},
+																val:        "[",
+																ignoreCase: false,
+																want:       "\"[\"",
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 44, col: 24, offset: 2941},
+																expr: &charClassMatcher{
+																	pos:        position{line: 44, col: 24, offset: 2941},
+																	val:        "[ \\t\\r\\n]",
+																	chars:      []rune{' ', '\t', '\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+															&choiceExpr{
+																pos: position{line: 23, col: 24, offset: 1615},
+																alternatives: []any{
+																	&seqExpr{
+																		pos: position{line: 23, col: 24, offset: 1615},
+																		exprs: []any{
+																			&zeroOrOneExpr{
+																				pos: position{line: 23, col: 24, offset: 1615},
+																				expr: &litMatcher{
+																					pos:        position{line: 23, col: 24, offset: 1615},
+																					val:        "-",
+																					ignoreCase: false,
+																					want:       "\"-\"",
+																				},
+																			},
+																			&choiceExpr{
+																				pos: position{line: 29, col: 24, offset: 1899},
+																				alternatives: []any{
+																					&litMatcher{
+																						pos:        position{line: 29, col: 24, offset: 1899},
+																						val:        "0",
+																						ignoreCase: false,
+																						want:       "\"0\"",
+																					},
+																					&seqExpr{
+																						pos: position{line: 29, col: 30, offset: 1905},
+																						exprs: []any{
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2094},
+																								val:        "[1-9]",
+																								ranges:     []rune{'1', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&zeroOrMoreExpr{
+																								pos: position{line: 29, col: 50, offset: 1925},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 31, col: 24, offset: 2065},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 33, col: 24, offset: 2123},
+																		run: (*parser).callonPrimary414,
+																		expr: &seqExpr{
+																			pos: position{line: 33, col: 24, offset: 2123},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 33, col: 24, offset: 2123},
+																					val:        "\"",
+																					ignoreCase: false,
+																					want:       "\"\\\"\"",
+																				},
+																				&zeroOrMoreExpr{
+																					pos: position{line: 34, col: 24, offset: 2226},
+																					expr: &choiceExpr{
+																						pos: position{line: 34, col: 26, offset: 2228},
+																						alternatives: []any{
+																							&seqExpr{
+																								pos: position{line: 34, col: 26, offset: 2228},
+																								exprs: []any{
+																									&notExpr{
+																										pos: position{line: 34, col: 26, offset: 2228},
+																										expr: &charClassMatcher{
+																											pos:        position{line: 35, col: 24, offset: 2291},
+																											val:        "[\"\\\\\\x00-\\x1f]",
+																											chars:      []rune{'"', '\\'},
+																											ranges:     []rune{'\x00', '\x1f'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																									&anyMatcher{
+																										line: 34, col: 39, offset: 2241,
+																									},
+																								},
+																							},
+																							&seqExpr{
+																								pos: position{line: 34, col: 43, offset: 2245},
+																								exprs: []any{
+																									&litMatcher{
+																										pos:        position{line: 34, col: 43, offset: 2245},
+																										val:        "\\",
+																										ignoreCase: false,
+																										want:       "\"\\\\\"",
+																									},
+																									&choiceExpr{
+																										pos: position{line: 36, col: 24, offset: 2329},
+																										alternatives: []any{
+																											&charClassMatcher{
+																												pos:        position{line: 37, col: 24, offset: 2385},
+																												val:        "[\"\\\\/bfnrt]",
+																												chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&seqExpr{
+																												pos: position{line: 38, col: 24, offset: 2420},
+																												exprs: []any{
+																													&litMatcher{
+																														pos:        position{line: 38, col: 24, offset: 2420},
+																														val:        "u",
+																														ignoreCase: false,
+																														want:       "\"u\"",
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 39, col: 24, offset: 2483},
+																														val:        "[0-9a-f]i",
+																														ranges:     []rune{'0', '9', 'a', 'f'},
+																														ignoreCase: true,
+																														inverted:   false,
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 39, col: 24, offset: 2483},
+																														val:        "[0-9a-f]i",
+																														ranges:     []rune{'0', '9', 'a', 'f'},
+																														ignoreCase: true,
+																														inverted:   false,
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 39, col: 24, offset: 2483},
+																														val:        "[0-9a-f]i",
+																														ranges:     []rune{'0', '9', 'a', 'f'},
+																														ignoreCase: true,
+																														inverted:   false,
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 39, col: 24, offset: 2483},
+																														val:        "[0-9a-f]i",
+																														ranges:     []rune{'0', '9', 'a', 'f'},
+																														ignoreCase: true,
+																														inverted:   false,
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&litMatcher{
+																					pos:        position{line: 33, col: 40, offset: 2139},
+																					val:        "\"",
+																					ignoreCase: false,
+																					want:       "\"\\\"\"",
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 44, col: 24, offset: 2941},
+																expr: &charClassMatcher{
+																	pos:        position{line: 44, col: 24, offset: 2941},
+																	val:        "[ \\t\\r\\n]",
+																	chars:      []rune{' ', '\t', '\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+															&litMatcher{
+																pos:        position{line: 22, col: 59, offset: 1588},
+																val:        "]",
+																ignoreCase: false,
+																want:       "\"]\"",
+															},
 														},
 													},
 												},
@@ -1538,9 +2588,9 @@ var g = &grammar{
 							want:       "\"(\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 37, col: 24, offset: 2532},
+							pos: position{line: 44, col: 24, offset: 2941},
 							expr: &charClassMatcher{
-								pos:        position{line: 37, col: 24, offset: 2532},
+								pos:        position{line: 44, col: 24, offset: 2941},
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -1556,9 +2606,9 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 37, col: 24, offset: 2532},
+							pos: position{line: 44, col: 24, offset: 2941},
 							expr: &charClassMatcher{
-								pos:        position{line: 37, col: 24, offset: 2532},
+								pos:        position{line: 44, col: 24, offset: 2941},
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -1618,8 +2668,18 @@ func (p *parser) callonNotExpr2() (any, error) {
 	return p.cur.onNotExpr2(stack["expr"])
 }
 
+func (c *current) onPrimary60() (any, error) {
+	return parseString(c)
+}
+
+func (p *parser) callonPrimary60() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary60()
+}
+
 func (c *current) onPrimary6() (any, error) {
-	return Identifier(c.text), nil
+	return parseField(c)
 }
 
 func (p *parser) callonPrimary6() (any, error) {
@@ -1638,184 +2698,204 @@ func (p *parser) callonPrimary3() (any, error) {
 	return p.cur.onPrimary3(stack["field"])
 }
 
-func (c *current) onPrimary26() (any, error) {
-	return Identifier(c.text), nil
+func (c *current) onPrimary146() (any, error) {
+	return parseString(c)
+}
+
+func (p *parser) callonPrimary146() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary146()
+}
+
+func (c *current) onPrimary92() (any, error) {
+	return parseField(c)
 }
 
-func (p *parser) callonPrimary26() (any, error) {
+func (p *parser) callonPrimary92() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary26()
+	return p.cur.onPrimary92()
 }
 
-func (c *current) onPrimary63() (any, error) {
+func (c *current) onPrimary196() (any, error) {
 	return parseString(c)
 }
 
-func (p *parser) callonPrimary63() (any, error) {
+func (p *parser) callonPrimary196() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary63()
+	return p.cur.onPrimary196()
 }
 
-func (c *current) onPrimary83() (any, error) {
+func (c *current) onPrimary216() (any, error) {
 	return parseNumber(c)
 }
 
-func (p *parser) callonPrimary83() (any, error) {
+func (p *parser) callonPrimary216() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary83()
+	return p.cur.onPrimary216()
 }
 
-func (c *current) onPrimary98() (any, error) {
+func (c *current) onPrimary231() (any, error) {
 	return parseBool(c)
 }
 
-func (p *parser) callonPrimary98() (any, error) {
+func (p *parser) callonPrimary231() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary98()
+	return p.cur.onPrimary231()
 }
 
-func (c *current) onPrimary102() (any, error) {
+func (c *current) onPrimary235() (any, error) {
 	return Identifier(c.text), nil
 }
 
-func (p *parser) callonPrimary102() (any, error) {
+func (p *parser) callonPrimary235() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary102()
+	return p.cur.onPrimary235()
 }
 
-func (c *current) onPrimary122() (any, error) {
+func (c *current) onPrimary255() (any, error) {
 	return parseString(c)
 }
 
-func (p *parser) callonPrimary122() (any, error) {
+func (p *parser) callonPrimary255() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary122()
+	return p.cur.onPrimary255()
 }
 
-func (c *current) onPrimary142() (any, error) {
+func (c *current) onPrimary275() (any, error) {
 	return parseNumber(c)
 }
 
-func (p *parser) callonPrimary142() (any, error) {
+func (p *parser) callonPrimary275() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary142()
+	return p.cur.onPrimary275()
 }
 
-func (c *current) onPrimary157() (any, error) {
+func (c *current) onPrimary290() (any, error) {
 	return parseBool(c)
 }
 
-func (p *parser) callonPrimary157() (any, error) {
+func (p *parser) callonPrimary290() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary157()
+	return p.cur.onPrimary290()
 }
 
-func (c *current) onPrimary161() (any, error) {
+func (c *current) onPrimary294() (any, error) {
 	return Identifier(c.text), nil
 }
 
-func (p *parser) callonPrimary161() (any, error) {
+func (p *parser) callonPrimary294() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary161()
+	return p.cur.onPrimary294()
 }
 
-func (c *current) onPrimary59(head, tail any) (any, error) {
+func (c *current) onPrimary192(head, tail any) (any, error) {
 	return parseOneOfValues(head, tail)
 }
 
-func (p *parser) callonPrimary59() (any, error) {
+func (p *parser) callonPrimary192() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary59(stack["head"], stack["tail"])
+	return p.cur.onPrimary192(stack["head"], stack["tail"])
 }
 
-func (c *current) onPrimary52(values any) (any, error) {
+func (c *current) onPrimary185(values any) (any, error) {
 	return parseOneOfExpression(values)
 }
 
-func (p *parser) callonPrimary52() (any, error) {
+func (p *parser) callonPrimary185() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary52(stack["values"])
+	return p.cur.onPrimary185(stack["values"])
 }
 
-func (c *current) onPrimary175() (any, error) {
+func (c *current) onPrimary308() (any, error) {
 	return parseString(c)
 }
 
-func (p *parser) callonPrimary175() (any, error) {
+func (p *parser) callonPrimary308() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary175()
+	return p.cur.onPrimary308()
 }
 
-func (c *current) onPrimary195() (any, error) {
+func (c *current) onPrimary328() (any, error) {
 	return parseNumber(c)
 }
 
-func (p *parser) callonPrimary195() (any, error) {
+func (p *parser) callonPrimary328() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary195()
+	return p.cur.onPrimary328()
 }
 
-func (c *current) onPrimary210() (any, error) {
+func (c *current) onPrimary343() (any, error) {
 	return parseBool(c)
 }
 
-func (p *parser) callonPrimary210() (any, error) {
+func (p *parser) callonPrimary343() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary210()
+	return p.cur.onPrimary343()
 }
 
-func (c *current) onPrimary214() (any, error) {
+func (c *current) onPrimary347() (any, error) {
 	return Identifier(c.text), nil
 }
 
-func (p *parser) callonPrimary214() (any, error) {
+func (p *parser) callonPrimary347() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary214()
+	return p.cur.onPrimary347()
 }
 
-func (c *current) onPrimary23(field, op, value any) (any, error) {
+func (c *current) onPrimary89(field, op, value any) (any, error) {
 	return parseFieldExpression(field, op, value)
 }
 
-func (p *parser) callonPrimary23() (any, error) {
+func (p *parser) callonPrimary89() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary23(stack["field"], stack["op"], stack["value"])
+	return p.cur.onPrimary89(stack["field"], stack["op"], stack["value"])
 }
 
-func (c *current) onPrimary227() (any, error) {
-	return Identifier(c.text), nil
+func (c *current) onPrimary414() (any, error) {
+	return parseString(c)
+}
+
+func (p *parser) callonPrimary414() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary414()
+}
+
+func (c *current) onPrimary360() (any, error) {
+	return parseField(c)
 }
 
-func (p *parser) callonPrimary227() (any, error) {
+func (p *parser) callonPrimary360() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary227()
+	return p.cur.onPrimary360()
 }
 
-func (c *current) onPrimary225(field any) (any, error) {
+func (c *current) onPrimary358(field any) (any, error) {
 	return parseBoolFieldExpr(field)
 }
 
-func (p *parser) callonPrimary225() (any, error) {
+func (p *parser) callonPrimary358() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary225(stack["field"])
+	return p.cur.onPrimary358(stack["field"])
 }
 
 func (c *current) onParenExpr1(expr any) (any, error) {
@@ -32,6 +32,8 @@ func resolveFieldOperator(op any) (FieldOperator, error) {
 		return Equal, nil
 	case "~":
 		return Like, nil
+	case "@>":
+		return Contains, nil
 	default:
 		return 0, fmt.Errorf("unknown compare operator %q", op)
 	}
@@ -93,6 +95,10 @@ func parseFieldExpression(field, op, value any) (any, error) {
 	}, nil
 }
 
+func parseField(c *current) (any, error) {
+	return canonicalIdentifier(string(c.text))
+}
+
 func parseNumber(c *current) (any, error) {
 	val, err := strconv.ParseFloat(string(c.text), 64)
 	if err != nil {
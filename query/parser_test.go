@@ -87,6 +87,11 @@ func TestParser(t *testing.T) { //nolint:funlen
 			input: "tags:[]",
 			want:  "(= tags [])",
 		},
+		// Containment of one-of values.
+		{
+			input: "tags @> [urgent, bug]",
+			want:  "(@> tags [\"urgent\" \"bug\"])",
+		},
 		// A complex expression combining several constructs.
 		{
 			input: "status : 200 and eps < 0.003 and (req.fields.ext:[\"jpg\", \"png\"])",
@@ -177,6 +182,41 @@ func TestParser(t *testing.T) { //nolint:funlen
 			input: "name? and (age>20 or verified)",
 			want:  "(and (exists name true) (or (> age 20) (= verified true)))",
 		},
+		// Quoted field name containing dots and slashes.
+		{
+			input: "labels.`app.kubernetes.io/name`:web",
+			want:  "(= labels.`app.kubernetes.io/name` \"web\")",
+		},
+		// Quoted field name with a dash and presence operator.
+		{
+			input: "`x-request-id`?",
+			want:  "(exists `x-request-id` true)",
+		},
+		// Unnecessary quotes are dropped.
+		{
+			input: "`profile`.`age` >= 18",
+			want:  "(>= profile.age 18)",
+		},
+		// Index segments.
+		{
+			input: "items[0].sku = X and items[-1].status:shipped",
+			want:  "(and (= items[0].sku \"X\") (= items[-1].status \"shipped\"))",
+		},
+		// Key segment, whitespace inside brackets is dropped.
+		{
+			input: `attributes[ "color" ] = red`,
+			want:  `(= attributes["color"] "red")`,
+		},
+		// Key segment with quoted field name and presence operator.
+		{
+			input: "labels[\"app.kubernetes.io/name\"].`x-y`?",
+			want:  "(exists labels[\"app.kubernetes.io/name\"].`x-y` true)",
+		},
+		// Escaped backtick inside a quoted field name.
+		{
+			input: "`a\\`b`",
+			want:  "(= `a\\`b` true)",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,314 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	errUnterminatedQuote = errors.New("unterminated quoted field name")
+	errMalformedSegment  = errors.New("malformed path segment")
+)
+
+// SegmentKind defines the kind of a field path segment.
+type SegmentKind uint8
+
+const (
+	FieldSegment SegmentKind = iota + 1 // Named field, e.g. `address` in address.city
+	IndexSegment                        // Slice or array index, e.g. [0] or [-1]
+	KeySegment                          // Map key, e.g. ["color"]
+)
+
+func (k SegmentKind) String() string {
+	switch k {
+	case FieldSegment:
+		return "field"
+	case IndexSegment:
+		return "index"
+	case KeySegment:
+		return "key"
+	default:
+		return "unknown!"
+	}
+}
+
+// Segment is a single step of a field path.
+type Segment struct {
+	Kind  SegmentKind
+	Name  string // Field name or map key
+	Index int    // Slice or array index, negative values count from the end
+}
+
+func (s Segment) String() string {
+	switch s.Kind {
+	case FieldSegment:
+		return quoteSegment(s.Name)
+	case IndexSegment:
+		return "[" + strconv.Itoa(s.Index) + "]"
+	case KeySegment:
+		return "[" + quoteString(s.Name) + "]"
+	default:
+		return "unknown!"
+	}
+}
+
+// Segments iterates over the segments of the identifier path.
+//
+// Named segments are separated with dots. Backtick-quoted names are yielded unquoted, so a single segment
+// can hold dots, dashes and other characters which are not allowed in a bare field name,
+// e.g. labels.`app.kubernetes.io/name`. Bracketed suffixes yield index and key segments,
+// e.g. items[0].sku, items[-1] and attributes["color"].
+//
+// Empty or malformed segments yield a zero Segment and stop the iteration.
+func (i Identifier) Segments() iter.Seq[Segment] {
+	return func(yield func(Segment) bool) {
+		s := string(i)
+		for first := true; ; first = false {
+			seg, rest, err := nextSegment(s, first)
+			if err != nil {
+				yield(Segment{})
+				return
+			}
+
+			if !yield(seg) || rest == "" {
+				return
+			}
+
+			s = rest
+		}
+	}
+}
+
+// nextSegment cuts the first segment off the path s. Unless the segment is the first one in the path,
+// named segments are expected to be prefixed with a dot.
+func nextSegment(s string, first bool) (Segment, string, error) {
+	if strings.HasPrefix(s, "[") {
+		return nextBracketSegment(s)
+	}
+
+	if !first {
+		if !strings.HasPrefix(s, ".") {
+			return Segment{}, "", errMalformedSegment
+		}
+		s = s[1:]
+	}
+
+	if !strings.HasPrefix(s, "`") {
+		end := strings.IndexAny(s, ".[")
+		if end < 0 {
+			end = len(s)
+		}
+
+		if end == 0 {
+			return Segment{}, "", errMalformedSegment
+		}
+
+		return Segment{Kind: FieldSegment, Name: s[:end]}, s[end:], nil
+	}
+
+	name, rest, err := unquoteSegment(s)
+	if err != nil {
+		return Segment{}, "", err
+	}
+
+	if name == "" || (rest != "" && rest[0] != '.' && rest[0] != '[') {
+		return Segment{}, "", errMalformedSegment
+	}
+
+	return Segment{Kind: FieldSegment, Name: name}, rest, nil
+}
+
+// nextBracketSegment cuts an index or key segment, e.g. [0] or ["color"], off the path s.
+func nextBracketSegment(s string) (Segment, string, error) {
+	inner := strings.TrimLeft(s[1:], " \t\r\n")
+
+	if strings.HasPrefix(inner, `"`) {
+		end := closingQuote(inner)
+		if end < 0 {
+			return Segment{}, "", errUnterminatedQuote
+		}
+
+		key, err := strconv.Unquote(inner[:end+1])
+		if err != nil {
+			return Segment{}, "", err
+		}
+
+		rest, ok := strings.CutPrefix(strings.TrimLeft(inner[end+1:], " \t\r\n"), "]")
+		if !ok {
+			return Segment{}, "", errMalformedSegment
+		}
+
+		return Segment{Kind: KeySegment, Name: key}, rest, nil
+	}
+
+	end := strings.IndexByte(inner, ']')
+	if end < 0 {
+		return Segment{}, "", errMalformedSegment
+	}
+
+	idx, err := strconv.Atoi(strings.TrimSpace(inner[:end]))
+	if err != nil {
+		return Segment{}, "", fmt.Errorf("%w: %w", errMalformedSegment, err)
+	}
+
+	return Segment{Kind: IndexSegment, Index: idx}, inner[end+1:], nil
+}
+
+// closingQuote returns the position of the double quote terminating the string literal s,
+// or -1 if the literal is not terminated.
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+
+	return -1
+}
+
+// unquoteSegment cuts a backtick-quoted name off s and returns it unescaped.
+func unquoteSegment(s string) (string, string, error) {
+	var (
+		b       strings.Builder
+		escaped bool
+	)
+
+	for i := 1; i < len(s); i++ {
+		switch ch := s[i]; {
+		case escaped:
+			b.WriteByte(ch)
+			escaped = false
+		case ch == '\\':
+			escaped = true
+		case ch == '`':
+			return b.String(), s[i+1:], nil
+		default:
+			b.WriteByte(ch)
+		}
+	}
+
+	return "", "", errUnterminatedQuote
+}
+
+// quoteSegment returns the segment name the way it's written in a query: as is when it's a valid
+// bare name and backtick-quoted otherwise.
+func quoteSegment(seg string) string {
+	if isBareName(seg) {
+		return seg
+	}
+
+	var b strings.Builder
+	b.Grow(len(seg) + 2) //nolint:mnd
+	b.WriteByte('`')
+	for i := range len(seg) {
+		if seg[i] == '`' || seg[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(seg[i])
+	}
+	b.WriteByte('`')
+
+	return b.String()
+}
+
+// quoteString returns a double-quoted string literal for s using only the escape sequences
+// accepted by the grammar.
+func quoteString(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2) //nolint:mnd
+	b.WriteByte('"')
+
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == utf8.RuneError { //nolint:mnd
+				fmt.Fprintf(&b, `\u%04x`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// isBareName reports whether s matches the AlphaNumeric rule of the grammar.
+func isBareName(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := range len(s) {
+		ch := s[i]
+		switch {
+		case ch == '_', 'a' <= ch && ch <= 'z', 'A' <= ch && ch <= 'Z':
+		case '0' <= ch && ch <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// canonicalIdentifier re-renders every segment of the path, so the same field is always
+// represented by the same Identifier regardless of unnecessary quoting or whitespace in the query.
+func canonicalIdentifier(path string) (Identifier, error) {
+	segments, ok := segmentsOf(Identifier(path))
+	if !ok {
+		return "", fmt.Errorf("invalid field path %q", path)
+	}
+
+	return joinSegments(segments), nil
+}
+
+// segmentsOf collects the segments of the path. It reports false if the path is malformed.
+func segmentsOf(path Identifier) ([]Segment, bool) {
+	var segments []Segment
+
+	for seg := range path.Segments() {
+		if seg.Kind == 0 {
+			return nil, false
+		}
+		segments = append(segments, seg)
+	}
+
+	return segments, true
+}
+
+// joinSegments renders the segments as a canonical path.
+func joinSegments(segments []Segment) Identifier {
+	var b strings.Builder
+
+	for _, seg := range segments {
+		if seg.Kind == FieldSegment && b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(seg.String())
+	}
+
+	return Identifier(b.String())
+}
@@ -0,0 +1,214 @@
+package query_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+)
+
+func TestIdentifier_Segments(t *testing.T) { //nolint:funlen
+	field := func(name string) query.Segment {
+		return query.Segment{Kind: query.FieldSegment, Name: name}
+	}
+	index := func(idx int) query.Segment {
+		return query.Segment{Kind: query.IndexSegment, Index: idx}
+	}
+	key := func(name string) query.Segment {
+		return query.Segment{Kind: query.KeySegment, Name: name}
+	}
+
+	tests := []struct {
+		name string
+		id   query.Identifier
+		want []query.Segment
+	}{
+		{
+			name: "single segment",
+			id:   "name",
+			want: []query.Segment{field("name")},
+		},
+		{
+			name: "nested segments",
+			id:   "contact.address.city",
+			want: []query.Segment{field("contact"), field("address"), field("city")},
+		},
+		{
+			name: "quoted segment with dots",
+			id:   "labels.`app.kubernetes.io/name`",
+			want: []query.Segment{field("labels"), field("app.kubernetes.io/name")},
+		},
+		{
+			name: "quoted segment in the middle",
+			id:   "meta.`x-request-id`.value",
+			want: []query.Segment{field("meta"), field("x-request-id"), field("value")},
+		},
+		{
+			name: "escaped backtick and backslash",
+			id:   "`a\\`b\\\\c`",
+			want: []query.Segment{field("a`b\\c")},
+		},
+		{
+			name: "index segment",
+			id:   "items[0].sku",
+			want: []query.Segment{field("items"), index(0), field("sku")},
+		},
+		{
+			name: "negative index segment",
+			id:   "items[-1].status",
+			want: []query.Segment{field("items"), index(-1), field("status")},
+		},
+		{
+			name: "key segment",
+			id:   `attributes["color"]`,
+			want: []query.Segment{field("attributes"), key("color")},
+		},
+		{
+			name: "key segment with escapes and brackets",
+			id:   `labels["a]\"b"].x`,
+			want: []query.Segment{field("labels"), key(`a]"b`), field("x")},
+		},
+		{
+			name: "chained index segments",
+			id:   "matrix[1][-2]",
+			want: []query.Segment{field("matrix"), index(1), index(-2)},
+		},
+		{
+			name: "whitespace inside brackets",
+			id:   `m[ "k" ][ 2 ]`,
+			want: []query.Segment{field("m"), key("k"), index(2)},
+		},
+		{
+			name: "empty segment",
+			id:   "a..b",
+			want: []query.Segment{field("a"), {}},
+		},
+		{
+			name: "trailing dot",
+			id:   "a.",
+			want: []query.Segment{field("a"), {}},
+		},
+		{
+			name: "empty identifier",
+			id:   "",
+			want: []query.Segment{{}},
+		},
+		{
+			name: "unterminated quote",
+			id:   "a.`b.c",
+			want: []query.Segment{field("a"), {}},
+		},
+		{
+			name: "garbage after closing quote",
+			id:   "`a`b.c",
+			want: []query.Segment{{}},
+		},
+		{
+			name: "unterminated bracket",
+			id:   "items[0",
+			want: []query.Segment{field("items"), {}},
+		},
+		{
+			name: "non-numeric index",
+			id:   "items[x]",
+			want: []query.Segment{field("items"), {}},
+		},
+		{
+			name: "unterminated key",
+			id:   `items["x]`,
+			want: []query.Segment{field("items"), {}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, slices.Collect(test.id.Segments()))
+		})
+	}
+}
+
+func TestIdentifier_Segments_Break(t *testing.T) {
+	var got []string
+	for seg := range query.Identifier("a.`b.c`.d").Segments() {
+		got = append(got, seg.Name)
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"a", "b.c"}, got)
+}
+
+func TestSegment_String(t *testing.T) {
+	assert.Equal(t, "name", query.Segment{Kind: query.FieldSegment, Name: "name"}.String())
+	assert.Equal(t, "`x-request-id`", query.Segment{Kind: query.FieldSegment, Name: "x-request-id"}.String())
+	assert.Equal(t, "[-1]", query.Segment{Kind: query.IndexSegment, Index: -1}.String())
+	assert.Equal(t, `["a\"b\n"]`, query.Segment{Kind: query.KeySegment, Name: "a\"b\n"}.String())
+	assert.Equal(t, "unknown!", query.Segment{}.String())
+
+	assert.Equal(t, "field", query.FieldSegment.String())
+	assert.Equal(t, "index", query.IndexSegment.String())
+	assert.Equal(t, "key", query.KeySegment.String())
+	assert.Equal(t, "unknown!", query.SegmentKind(255).String())
+}
+
+func TestIdentifier_ToSql_Quoting(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      query.Identifier
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "bare path",
+			id:   "profile.age",
+			want: "profile.age",
+		},
+		{
+			name: "quoted segment",
+			id:   "labels.`app.kubernetes.io/name`",
+			want: `labels."app.kubernetes.io/name"`,
+		},
+		{
+			name: "double quote inside segment",
+			id:   "`say \"hi\"`",
+			want: `"say ""hi"""`,
+		},
+		{
+			name: "key segment",
+			id:   `labels["app.kubernetes.io/name"]`,
+			want: `labels."app.kubernetes.io/name"`,
+		},
+		{
+			name:    "index segment",
+			id:      "items[0].sku",
+			wantErr: true,
+		},
+		{
+			name:    "empty segment",
+			id:      "a..b",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated quote",
+			id:      "`a",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sql, args, err := test.id.ToSql()
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, sql)
+			assert.Empty(t, args)
+		})
+	}
+}
@@ -0,0 +1,477 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrTooManyClauses is returned by Simplify when the requested normal form has more clauses than allowed.
+var ErrTooManyClauses = errors.New("simplify: normal form has too many clauses")
+
+const defaultMaxClauses = 1024
+
+// SimplifyOption configures Simplify.
+type SimplifyOption func(*simplifier)
+
+// WithCNF makes Simplify convert the expression to conjunctive normal form, i.e. an `and` of `or` clauses.
+func WithCNF() SimplifyOption {
+	return func(s *simplifier) { s.form = Or }
+}
+
+// WithDNF makes Simplify convert the expression to disjunctive normal form, i.e. an `or` of `and` clauses.
+func WithDNF() SimplifyOption {
+	return func(s *simplifier) { s.form = And }
+}
+
+// WithMaxClauses limits the number of clauses produced by the normal form conversion, 1024 by default.
+// Converting to a normal form can grow the expression exponentially, so the limit protects against
+// user-provided queries like `(a or b) and (c or d) and ...`.
+func WithMaxClauses(n int) SimplifyOption {
+	return func(s *simplifier) { s.maxClauses = n }
+}
+
+// Simplify returns an equivalent expression with redundancy removed:
+//   - nested `and` and `or` chains are flattened and rebuilt left-associative, the way the parser builds them;
+//   - double negations are removed and negations are pushed down to field expressions with De Morgan's laws;
+//   - duplicate operands are removed, e.g. `a and a` becomes `a`;
+//   - absorbed operands are removed, e.g. `x or (x and y)` becomes `x`;
+//   - equality checks of the same field are merged into one-of, e.g. `a=1 or a=2` becomes `a:[1, 2]`,
+//     and `not a=1 and not a=2` becomes `not a:[1, 2]`.
+//
+// Negated field expressions are kept as `not` instead of inverting their operators, because `not a>1`
+// and `a<=1` differ for missing fields and values of other types.
+//
+// WithCNF and WithDNF additionally convert the result to a normal form. Simplify never modifies the input
+// tree and returns an error if the expression contains nil nodes or unknown boolean operators.
+func Simplify(expr Expr, opts ...SimplifyOption) (Expr, error) {
+	s := &simplifier{maxClauses: defaultMaxClauses}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	n, err := s.nnf(expr, false)
+	if err != nil {
+		return nil, err
+	}
+
+	n = merge(s.simplify(n))
+
+	if s.form != 0 {
+		if n, err = s.normalForm(n); err != nil {
+			return nil, err
+		}
+	}
+
+	return n.expr(), nil
+}
+
+type simplifier struct {
+	form       BooleanOperator // Operator joining literals inside the clauses of the normal form
+	maxClauses int
+}
+
+// snode is an n-ary boolean expression in negation normal form. Nodes without an operator are literals:
+// possibly negated field expressions or expressions of unknown types.
+type snode struct {
+	op       BooleanOperator
+	children []*snode
+
+	leaf Expr
+	neg  bool
+	key  string
+}
+
+func literal(leaf Expr, neg bool) *snode {
+	var key string
+	if f, ok := leaf.(*FieldExpr); ok {
+		key = fieldKey(f)
+	} else {
+		key = fmt.Sprintf("%T %s", leaf, leaf)
+	}
+
+	if neg {
+		key = "!" + key
+	}
+
+	return &snode{leaf: leaf, neg: neg, key: key}
+}
+
+// nnf converts expr to negation normal form, negating it if neg is set.
+func (s *simplifier) nnf(expr Expr, neg bool) (*snode, error) {
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		if e == nil {
+			return nil, errors.New("simplify: nil expression")
+		}
+
+		op := e.Op
+		if op != And && op != Or {
+			return nil, fmt.Errorf("simplify: unknown operator %q", e.Op)
+		}
+
+		if neg {
+			op = dual(op)
+		}
+
+		left, err := s.nnf(e.Left, neg)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := s.nnf(e.Right, neg)
+		if err != nil {
+			return nil, err
+		}
+
+		return &snode{op: op, children: []*snode{left, right}}, nil
+	case *NotExpr:
+		if e == nil {
+			return nil, errors.New("simplify: nil expression")
+		}
+
+		return s.nnf(e.Expr, !neg)
+	case *FieldExpr:
+		if e == nil {
+			return nil, errors.New("simplify: nil expression")
+		}
+
+		return literal(e, neg), nil
+	case nil:
+		return nil, errors.New("simplify: nil expression")
+	default:
+		return literal(expr, neg), nil
+	}
+}
+
+func dual(op BooleanOperator) BooleanOperator {
+	if op == And {
+		return Or
+	}
+	return And
+}
+
+// simplify simplifies the children of n bottom-up, then flattens, deduplicates and absorbs
+// the operands of n itself.
+func (s *simplifier) simplify(n *snode) *snode {
+	if n.op == 0 {
+		return n
+	}
+
+	var children []*snode
+
+	for _, child := range n.children {
+		child = s.simplify(child)
+		if child.op == n.op {
+			children = append(children, child.children...)
+		} else {
+			children = append(children, child)
+		}
+	}
+
+	children = absorb(dedupe(children))
+
+	if len(children) == 1 {
+		return children[0]
+	}
+
+	return &snode{op: n.op, children: children}
+}
+
+// merge merges equality literals bottom-up. It runs after simplify, so literals merged into one-of
+// don't hide duplicates and absorbed operands from it.
+func merge(n *snode) *snode {
+	if n.op == 0 {
+		return n
+	}
+
+	children := make([]*snode, 0, len(n.children))
+	for _, child := range n.children {
+		children = append(children, merge(child))
+	}
+
+	children = dedupe(mergeEquality(children, n.op))
+
+	if len(children) == 1 {
+		return children[0]
+	}
+
+	return &snode{op: n.op, children: children}
+}
+
+func (n *snode) id() string {
+	if n.op == 0 {
+		return n.key
+	}
+
+	ids := make([]string, 0, len(n.children))
+	for _, child := range n.children {
+		ids = append(ids, child.id())
+	}
+	slices.Sort(ids)
+
+	return fmt.Sprintf("(%s %s)", n.op, strings.Join(ids, " "))
+}
+
+func dedupe(nodes []*snode) []*snode {
+	seen := make(map[string]struct{}, len(nodes))
+
+	return slices.DeleteFunc(nodes, func(n *snode) bool {
+		id := n.id()
+		if _, ok := seen[id]; ok {
+			return true
+		}
+		seen[id] = struct{}{}
+		return false
+	})
+}
+
+// absorb removes operands which contain all parts of another operand, e.g. `x and y` in `x or (x and y)`.
+// Operands must be flattened and deduplicated.
+func absorb(nodes []*snode) []*snode {
+	parts := make([]map[string]struct{}, len(nodes))
+	for i, n := range nodes {
+		parts[i] = make(map[string]struct{})
+		if n.op == 0 {
+			parts[i][n.id()] = struct{}{}
+			continue
+		}
+		for _, child := range n.children {
+			parts[i][child.id()] = struct{}{}
+		}
+	}
+
+	absorbed := make([]bool, len(nodes))
+
+	for i := range nodes {
+		for j := range nodes {
+			if i == j || absorbed[j] || len(parts[j]) >= len(parts[i]) || !isSubset(parts[j], parts[i]) {
+				continue
+			}
+
+			absorbed[i] = true
+			break
+		}
+	}
+
+	var i int
+
+	return slices.DeleteFunc(nodes, func(*snode) bool {
+		i++
+		return absorbed[i-1]
+	})
+}
+
+func isSubset(a, b map[string]struct{}) bool {
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeEquality merges equality literals of the same field into a single one-of literal: positive ones
+// joined with `or` and negated ones joined with `and`. The merged literal takes the position of the first one.
+func mergeEquality(nodes []*snode, op BooleanOperator) []*snode {
+	neg := op == And
+
+	var (
+		groups = make(map[Identifier][]*FieldExpr)
+		first  = make(map[Identifier]int)
+	)
+
+	for i, n := range nodes {
+		if f, ok := mergeable(n, neg); ok {
+			if _, seen := first[f.Field]; !seen {
+				first[f.Field] = i
+			}
+			groups[f.Field] = append(groups[f.Field], f)
+		}
+	}
+
+	merged := make([]*snode, 0, len(nodes))
+
+	for i, n := range nodes {
+		f, ok := mergeable(n, neg)
+		if !ok || len(groups[f.Field]) < 2 { //nolint:mnd
+			merged = append(merged, n)
+			continue
+		}
+
+		if first[f.Field] == i {
+			merged = append(merged, literal(mergeFields(groups[f.Field]), neg))
+		}
+	}
+
+	return merged
+}
+
+func mergeable(n *snode, neg bool) (*FieldExpr, bool) {
+	f, ok := n.leaf.(*FieldExpr)
+	if !ok || n.op != 0 || n.neg != neg || f.Op != Equal || isNil(f.Value) {
+		return nil, false
+	}
+
+	if oneOf, ok := f.Value.(*OneOfExpr); ok {
+		return f, oneOf != nil && !slices.ContainsFunc(oneOf.Values, func(v Valuer) bool {
+			_, nested := v.(*OneOfExpr)
+			return nested || isNil(v)
+		})
+	}
+
+	return f, true
+}
+
+func mergeFields(fields []*FieldExpr) *FieldExpr {
+	var (
+		values []Valuer
+		seen   = make(map[string]struct{})
+	)
+
+	add := func(v Valuer) {
+		key := valueKey(v)
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			values = append(values, v)
+		}
+	}
+
+	for _, f := range fields {
+		if oneOf, ok := f.Value.(*OneOfExpr); ok {
+			for _, v := range oneOf.Values {
+				add(v)
+			}
+			continue
+		}
+		add(f.Value)
+	}
+
+	if len(values) == 1 {
+		return &FieldExpr{Field: fields[0].Field, Op: Equal, Value: values[0]}
+	}
+
+	return &FieldExpr{Field: fields[0].Field, Op: Equal, Value: &OneOfExpr{Values: values}}
+}
+
+// normalForm converts n to CNF or DNF depending on s.form.
+func (s *simplifier) normalForm(n *snode) (*snode, error) {
+	clauses, err := s.clauses(n)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &snode{op: dual(s.form)}
+	for _, clause := range clauses {
+		root.children = append(root.children, &snode{op: s.form, children: clause})
+	}
+
+	// Simplifying an expression in normal form keeps it in normal form: literals are only removed or merged.
+	return merge(s.simplify(root)), nil
+}
+
+// clauses returns the clauses of the normal form of n as lists of literals joined with s.form.
+func (s *simplifier) clauses(n *snode) ([][]*snode, error) {
+	if n.op == 0 {
+		return [][]*snode{{n}}, nil
+	}
+
+	if n.op != s.form {
+		var clauses [][]*snode
+
+		for _, child := range n.children {
+			cc, err := s.clauses(child)
+			if err != nil {
+				return nil, err
+			}
+
+			clauses = append(clauses, cc...)
+			if len(clauses) > s.maxClauses {
+				return nil, ErrTooManyClauses
+			}
+		}
+
+		return clauses, nil
+	}
+
+	// Distribute: every combination of the clauses of the children forms a clause.
+	clauses := [][]*snode{nil}
+
+	for _, child := range n.children {
+		cc, err := s.clauses(child)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(clauses)*len(cc) > s.maxClauses {
+			return nil, ErrTooManyClauses
+		}
+
+		product := make([][]*snode, 0, len(clauses)*len(cc))
+		for _, a := range clauses {
+			for _, b := range cc {
+				product = append(product, append(slices.Clip(a), b...))
+			}
+		}
+
+		clauses = product
+	}
+
+	return clauses, nil
+}
+
+// expr builds the expression back, joining operands into left-associative chains.
+func (n *snode) expr() Expr {
+	if n.op == 0 {
+		if n.neg {
+			return &NotExpr{Expr: n.leaf}
+		}
+		return n.leaf
+	}
+
+	res := n.children[0].expr()
+	for _, child := range n.children[1:] {
+		res = &BinaryExpr{Left: res, Op: n.op, Right: child.expr()}
+	}
+
+	return res
+}
+
+func fieldKey(f *FieldExpr) string {
+	return fmt.Sprintf("%s %s %s", f.Field, f.Op, valueKey(f.Value))
+}
+
+// valueKey returns a string which is equal for equal values. Unlike String it keeps the exact value of numbers.
+func valueKey(v Valuer) string {
+	switch val := v.(type) {
+	case *NumberLiteral:
+		if val != nil && val.NumberValue == 0 {
+			return "n0" // Negative zero equals zero
+		}
+		if val != nil {
+			return "n" + strconv.FormatFloat(val.NumberValue, 'g', -1, 64)
+		}
+	case *StringLiteral:
+		if val != nil {
+			return "s" + strconv.Quote(val.StringValue)
+		}
+	case Identifier:
+		return "i" + strconv.Quote(string(val))
+	case *BoolLiteral:
+		if val != nil {
+			return "b" + strconv.FormatBool(val.BoolValue)
+		}
+	case *OneOfExpr:
+		if val != nil {
+			keys := make([]string, 0, len(val.Values))
+			for _, v := range val.Values {
+				keys = append(keys, valueKey(v))
+			}
+			return "[" + strings.Join(keys, ",") + "]"
+		}
+	}
+
+	return fmt.Sprintf("%T %v", v, v)
+}
@@ -0,0 +1,237 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/match"
+	"go.tomakado.io/dumbql/query"
+)
+
+func TestSimplify(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: `a:1`,
+			want:  `(= a 1)`,
+		},
+		// Duplicates.
+		{
+			input: `a:1 and a:1`,
+			want:  `(= a 1)`,
+		},
+		{
+			input: `a:1 or b:2 or a:1`,
+			want:  `(or (= a 1) (= b 2))`,
+		},
+		{
+			input: `(a:1 and b:2) or (b:2 and a:1)`,
+			want:  `(and (= a 1) (= b 2))`,
+		},
+		// Double negation.
+		{
+			input: `not (not x)`,
+			want:  `(= x true)`,
+		},
+		// De Morgan.
+		{
+			input: `not (a:1 or b>2)`,
+			want:  `(and (not (= a 1)) (not (> b 2)))`,
+		},
+		{
+			input: `not (a:1 and not b:2)`,
+			want:  `(or (not (= a 1)) (= b 2))`,
+		},
+		// Flattening.
+		{
+			input: `a:1 and (b:2 and (c:3 and d:4))`,
+			want:  `(and (and (and (= a 1) (= b 2)) (= c 3)) (= d 4))`,
+		},
+		// Absorption.
+		{
+			input: `x or (x and y)`,
+			want:  `(= x true)`,
+		},
+		{
+			input: `(x and y and z) or (y and x)`,
+			want:  `(and (= y true) (= x true))`,
+		},
+		{
+			input: `x and (y or x)`,
+			want:  `(= x true)`,
+		},
+		// One-of merging.
+		{
+			input: `a:1 or a:2`,
+			want:  `(= a [1 2])`,
+		},
+		{
+			input: `a:1 or b:x or a:[2, 1] or a:3`,
+			want:  `(or (= a [1 2 3]) (= b "x"))`,
+		},
+		{
+			input: `not (a:x or a:y)`,
+			want:  `(not (= a ["x" "y"]))`,
+		},
+		{
+			input: `a:1 and a:2`,
+			want:  `(and (= a 1) (= a 2))`,
+		},
+		{
+			input: `a>1 or a>2`,
+			want:  `(or (> a 1) (> a 2))`,
+		},
+		// Negated field expressions keep their operators.
+		{
+			input: `not a>1`,
+			want:  `(not (> a 1))`,
+		},
+		// Numbers are compared exactly.
+		{
+			input: `a:0.0000001 and a:0.0000002`,
+			want:  `(and (= a 0.000000) (= a 0.000000))`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			expr := mustParse(t, test.input)
+			before := expr.String()
+
+			got, err := query.Simplify(expr)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got.String())
+			assert.Equal(t, before, expr.String(), "input tree must not be modified")
+		})
+	}
+}
+
+func TestSimplify_NormalForms(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		name  string
+		input string
+		opt   query.SimplifyOption
+		want  string
+	}{
+		{
+			name:  "cnf distributes or over and",
+			input: `a:1 or (b:2 and c:3)`,
+			opt:   query.WithCNF(),
+			want:  `(and (or (= a 1) (= b 2)) (or (= a 1) (= c 3)))`,
+		},
+		{
+			name:  "dnf distributes and over or",
+			input: `(a:1 or b:2) and (c:3 or d:4)`,
+			opt:   query.WithDNF(),
+			want: `(or (or (or (and (= a 1) (= c 3)) (and (= a 1) (= d 4))) (and (= b 2) (= c 3)))` +
+				` (and (= b 2) (= d 4)))`,
+		},
+		{
+			name:  "dnf absorbs clauses",
+			input: `(a:1 or b:2) and (a:1 or c:3)`,
+			opt:   query.WithDNF(),
+			want:  `(or (= a 1) (and (= b 2) (= c 3)))`,
+		},
+		{
+			name:  "cnf merges one-of",
+			input: `a:1 or (a:2 and b:3)`,
+			opt:   query.WithCNF(),
+			want:  `(and (= a [1 2]) (or (= a 1) (= b 3)))`,
+		},
+		{
+			name:  "cnf pushes negations",
+			input: `not (a:1 and (b:2 or c:3))`,
+			opt:   query.WithCNF(),
+			want:  `(and (or (not (= a 1)) (not (= b 2))) (or (not (= a 1)) (not (= c 3))))`,
+		},
+		{
+			name:  "already in normal form",
+			input: `a:1 and (b:2 or c:3)`,
+			opt:   query.WithCNF(),
+			want:  `(and (= a 1) (or (= b 2) (= c 3)))`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := query.Simplify(mustParse(t, test.input), test.opt)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got.String())
+		})
+	}
+}
+
+func TestSimplify_TooManyClauses(t *testing.T) {
+	expr := mustParse(t, `(a:1 or b:1) and (a:2 or b:2) and (a:3 or b:3) and (a:4 or b:4)`)
+
+	_, err := query.Simplify(expr, query.WithDNF(), query.WithMaxClauses(8))
+	require.ErrorIs(t, err, query.ErrTooManyClauses)
+
+	_, err = query.Simplify(expr, query.WithDNF(), query.WithMaxClauses(16))
+	require.NoError(t, err)
+
+	_, err = query.Simplify(expr, query.WithCNF(), query.WithMaxClauses(4))
+	require.NoError(t, err, "expression is already in CNF")
+}
+
+func TestSimplify_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		expr query.Expr
+	}{
+		{name: "nil", expr: nil},
+		{name: "nil operand", expr: &query.BinaryExpr{Left: &query.FieldExpr{Field: "a"}, Op: query.And}},
+		{name: "nil negation", expr: &query.NotExpr{}},
+		{
+			name: "unknown operator",
+			expr: &query.BinaryExpr{Left: &query.FieldExpr{Field: "a"}, Op: 42, Right: &query.FieldExpr{Field: "b"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := query.Simplify(test.expr)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSimplify_Equivalence(t *testing.T) {
+	type record struct {
+		A int  `dumbql:"a"`
+		B int  `dumbql:"b"`
+		C bool `dumbql:"c"`
+	}
+
+	queries := []string{
+		`not (a:1 or (b:2 and not c)) or a:[2, 3]`,
+		`(a:1 or a:2 or b>1) and not (not c or a:1)`,
+		`(a>=1 or b:0) and (a<2 or c) and (b!=1 or a:0)`,
+		`c or (c and a:1) or not (b:1 and b:2)`,
+	}
+
+	opts := [][]query.SimplifyOption{nil, {query.WithCNF()}, {query.WithDNF()}}
+	matcher := &match.StructMatcher{}
+
+	for _, q := range queries {
+		expr := mustParse(t, q)
+
+		for _, opt := range opts {
+			simplified, err := query.Simplify(expr, opt...)
+			require.NoError(t, err)
+
+			for a := range 4 {
+				for b := range 3 {
+					for _, c := range []bool{false, true} {
+						r := &record{A: a, B: b, C: c}
+						assert.Equal(t, expr.Match(r, matcher), simplified.Match(r, matcher),
+							"%s vs %s on %+v", expr, simplified, r)
+					}
+				}
+			}
+		}
+	}
+}
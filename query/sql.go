@@ -2,6 +2,7 @@ package query
 
 import (
 	"fmt"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 )
@@ -19,26 +20,84 @@ func (b *BoolLiteral) ToSql() (string, []any, error) { //nolint:revive
 }
 
 func (i Identifier) ToSql() (string, []any, error) { //nolint:revive
-	return string(i), nil, nil
+	column, err := i.column()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return column, nil, nil
 }
 
+// column renders the identifier as an SQL column reference. Quoted names and map keys are emitted
+// as quoted SQL identifiers, so each of them is treated as a single key. Index segments have no
+// column counterpart and are rejected.
+func (i Identifier) column() (string, error) {
+	var b strings.Builder
+
+	for seg := range i.Segments() {
+		switch seg.Kind {
+		case FieldSegment, KeySegment:
+		case IndexSegment:
+			return "", fmt.Errorf("field %q: index segments are not supported in SQL", string(i))
+		default:
+			return "", fmt.Errorf("invalid field %q", string(i))
+		}
+
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+
+		if seg.Kind == FieldSegment && isBareName(seg.Name) {
+			b.WriteString(seg.Name)
+			continue
+		}
+
+		b.WriteByte('"')
+		b.WriteString(strings.ReplaceAll(seg.Name, `"`, `""`))
+		b.WriteByte('"')
+	}
+
+	return b.String(), nil
+}
+
+// ToSql renders the values as a parenthesized list of placeholders, e.g. (?,?,?), to be used with IN.
+// An empty list is rendered as (NULL), which never matches with IN and NOT IN.
 func (o *OneOfExpr) ToSql() (string, []any, error) { //nolint:revive
-	return "?", []any{o.Value()}, nil
+	if len(o.Values) == 0 {
+		return "(NULL)", nil, nil
+	}
+
+	args := make([]any, 0, len(o.Values))
+	for _, v := range o.Values {
+		args = append(args, v.Value())
+	}
+
+	return "(" + sq.Placeholders(len(args)) + ")", args, nil
 }
 
 func (b *BinaryExpr) ToSql() (string, []any, error) { //nolint:revive
+	return b.toSql(LikeContains)
+}
+
+func (b *BinaryExpr) toSql(like LikeMode) (string, []any, error) { //nolint:revive
+	left, right := like.Sqlizer(b.Left), like.Sqlizer(b.Right)
+
 	switch b.Op {
 	case And:
-		return sq.And{b.Left, b.Right}.ToSql()
+		return sq.And{left, right}.ToSql()
 	case Or:
-		return sq.Or{b.Left, b.Right}.ToSql()
+		return sq.Or{left, right}.ToSql()
 	}
 
 	return "", nil, fmt.Errorf("unknown operator %q", b.Op)
 }
 
 func (n *NotExpr) ToSql() (string, []any, error) { //nolint:revive
-	sql, args, err := n.Expr.ToSql()
+	return n.toSql(LikeContains)
+}
+
+func (n *NotExpr) toSql(like LikeMode) (string, []any, error) { //nolint:revive
+	sql, args, err := like.Sqlizer(n.Expr).ToSql()
 	if err != nil {
 		return "", nil, err
 	}
@@ -46,8 +105,19 @@ func (n *NotExpr) ToSql() (string, []any, error) { //nolint:revive
 	return sq.Expr("NOT "+sql, args...).ToSql()
 }
 
+// ToSql renders `~` as a LIKE matching values containing the string, the way StructMatcher does it
+// by default, with the wildcards of the value escaped. Use LikeMode.Sqlizer to match prefixes or suffixes.
 func (f *FieldExpr) ToSql() (string, []any, error) { //nolint:revive
-	field, value := f.Field.String(), f.Value.Value()
+	return f.toSql(LikeContains)
+}
+
+func (f *FieldExpr) toSql(like LikeMode) (string, []any, error) { //nolint:revive
+	field, err := f.Field.column()
+	if err != nil {
+		return "", nil, err
+	}
+
+	value := f.Value.Value()
 
 	var sqlizer sq.Sqlizer
 
@@ -65,12 +135,30 @@ func (f *FieldExpr) ToSql() (string, []any, error) { //nolint:revive
 	case LessThanOrEqual:
 		sqlizer = sq.LtOrEq{field: value}
 	case Like:
-		sqlizer = sq.Like{field: value}
+		sqlizer = likeSqlizer(field, f.Value, like)
 	case Exists:
-		sqlizer = sq.NotEq{f.Field.String(): nil}
+		sqlizer = sq.NotEq{field: nil}
+	case Contains:
+		return "", nil, fmt.Errorf("field %q: operator %q requires an array column of SQLRenderer", f.Field, f.Op)
 	default:
 		return "", nil, fmt.Errorf("unknown operator %q", f.Op)
 	}
 
 	return sqlizer.ToSql()
 }
+
+// likeSqlizer renders `~` as a LIKE matching values in the given mode. One-of values match if any of them matches.
+func likeSqlizer(field string, v Valuer, like LikeMode) sq.Sqlizer {
+	if oneOf, ok := v.(*OneOfExpr); ok {
+		or := make(sq.Or, 0, len(oneOf.Values))
+		for _, item := range oneOf.Values {
+			or = append(or, likeSqlizer(field, item, like))
+		}
+
+		return or
+	}
+
+	pattern := like.pattern(escapeLike(fmt.Sprint(v.Value()), likeEscape, ""))
+
+	return sq.Expr(field+" LIKE ? ESCAPE '"+string(rune(likeEscape))+"'", pattern)
+}
@@ -54,11 +54,13 @@ func TestComplexSqlGeneration(t *testing.T) {
 		oe := &query.OneOfExpr{Values: values}
 		sql, args, err := oe.ToSql()
 		require.NoError(t, err)
-		assert.Equal(t, "?", sql)
-		// The Values should be a slice of string/number values
-		assert.Contains(t, args[0], "one")
-		assert.Contains(t, args[0], "two")
-		assert.Contains(t, args[0], float64(3))
+		assert.Equal(t, "(?,?,?)", sql)
+		assert.Equal(t, []any{"one", "two", float64(3)}, args)
+
+		sql, args, err = (&query.OneOfExpr{}).ToSql()
+		require.NoError(t, err)
+		assert.Equal(t, "(NULL)", sql)
+		assert.Empty(t, args)
 	})
 
 	t.Run("BinaryExpr_OR_operator", func(t *testing.T) {
@@ -0,0 +1,150 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonExtract renders the extraction of the value at path from the JSON document in column, cast to typ:
+//
+//	Postgres:   ("profile"->'address'->>'city'), ("profile"->>'age')::numeric
+//	MySQL:      JSON_UNQUOTE(JSON_EXTRACT(`profile`, '$.address.city')), CAST(JSON_EXTRACT(...) AS DOUBLE)
+//	SQLite:     json_extract("profile", '$.address.city')
+//	SQL Server: JSON_VALUE([profile], '$.address.city'), CAST(JSON_VALUE(...) AS float)
+//
+// Booleans are compared with the literals rendered by jsonBoolean.
+func (d Dialect) jsonExtract(column string, path []Segment, typ literalType) (string, error) {
+	if d == Postgres {
+		return postgresJSONExtract(column, path, typ), nil
+	}
+
+	p, err := d.jsonPath(path)
+	if err != nil {
+		return "", err
+	}
+
+	switch d { //nolint:exhaustive
+	case MySQL:
+		extract := "JSON_EXTRACT(" + column + ", " + p + ")"
+
+		switch typ {
+		case numberType:
+			return "CAST(" + extract + " AS DOUBLE)", nil
+		case boolType:
+			return extract, nil
+		default:
+			return "JSON_UNQUOTE(" + extract + ")", nil
+		}
+	case SQLServer:
+		extract := "JSON_VALUE(" + column + ", " + p + ")"
+
+		if typ == numberType {
+			return "CAST(" + extract + " AS float)", nil
+		}
+
+		return extract, nil
+	default:
+		// SQLite extracts SQL values: numbers, text and 1 or 0 for booleans.
+		return "json_extract(" + column + ", " + p + ")", nil
+	}
+}
+
+func postgresJSONExtract(column string, path []Segment, typ literalType) string {
+	var b strings.Builder
+
+	b.WriteString("(" + column)
+
+	for i, seg := range path {
+		if i == len(path)-1 {
+			b.WriteString("->>")
+		} else {
+			b.WriteString("->")
+		}
+
+		if seg.Kind == IndexSegment {
+			b.WriteString(strconv.Itoa(seg.Index))
+		} else {
+			b.WriteString(sqlString(seg.Name))
+		}
+	}
+
+	b.WriteByte(')')
+
+	switch typ {
+	case numberType:
+		b.WriteString("::numeric")
+	case boolType:
+		b.WriteString("::boolean")
+	case textType:
+	}
+
+	return b.String()
+}
+
+// jsonBoolean renders the boolean literal compared with values extracted from JSON documents.
+func (d Dialect) jsonBoolean(v bool) string {
+	switch d { //nolint:exhaustive
+	case MySQL:
+		return "CAST(" + d.boolean(v) + " AS JSON)"
+	case SQLServer:
+		// JSON_VALUE returns booleans as 'true' and 'false'.
+		return sqlString(strconv.FormatBool(v))
+	default:
+		return d.boolean(v)
+	}
+}
+
+// jsonPath renders the segments as an SQL/JSON path string literal, e.g. '$.address."zip code"[0]'.
+// Keys containing quotes or backslashes are rejected, since their escaping differs between databases.
+func (d Dialect) jsonPath(path []Segment) (string, error) {
+	var b strings.Builder
+
+	b.WriteByte('$')
+
+	for _, seg := range path {
+		switch {
+		case seg.Kind == IndexSegment:
+			index, err := d.jsonIndex(seg.Index)
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString("[" + index + "]")
+		case strings.ContainsAny(seg.Name, `"'\`):
+			return "", fmt.Errorf("JSON key %q contains unsupported characters", seg.Name)
+		case isBareName(seg.Name):
+			b.WriteString("." + seg.Name)
+		default:
+			b.WriteString(`."` + seg.Name + `"`)
+		}
+	}
+
+	return "'" + b.String() + "'", nil
+}
+
+// jsonIndex renders an array index of a JSON path. Negative indexes count from the end of the array,
+// e.g. -1 is rendered as last in MySQL and as #-1 in SQLite. SQL Server doesn't support them.
+func (d Dialect) jsonIndex(index int) (string, error) {
+	if index >= 0 {
+		return strconv.Itoa(index), nil
+	}
+
+	switch d { //nolint:exhaustive
+	case MySQL:
+		if index == -1 {
+			return "last", nil
+		}
+
+		return "last-" + strconv.Itoa(-index-1), nil
+	case SQLite:
+		return "#" + strconv.Itoa(index), nil
+	default:
+		return "", fmt.Errorf("JSON index %d: negative indexes are not supported by %s", index, d)
+	}
+}
+
+// sqlString renders s as an SQL string literal.
+func sqlString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,94 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Cardinality defines how many records of a related table belong to a record.
+type Cardinality uint8
+
+const (
+	ToOne  Cardinality = iota + 1 // At most one related record, e.g. the author of a post
+	ToMany                        // Any number of related records, e.g. the comments of a post
+)
+
+func (c Cardinality) String() string {
+	switch c {
+	case ToOne:
+		return "to-one"
+	case ToMany:
+		return "to-many"
+	default:
+		return "unknown!"
+	}
+}
+
+// Relation describes a table related to the queried one, so fields like `author.country` can be filtered
+// by the columns of the related table.
+//
+// Fields of to-many relations are rendered as `EXISTS (SELECT 1 FROM <table> WHERE <on> AND <condition>)`,
+// so the results aren't duplicated. Fields of to-one relations are rendered as columns qualified with
+// the table (or its alias), the relation has to be joined in the query. Every field expression gets its own
+// subquery: `comments.author:john and comments.likes>10` matches if any comment is by john and any
+// comment has more than 10 likes.
+type Relation struct {
+	Table       string // Related table, e.g. "authors" or "blog.authors"
+	Alias       string // Optional alias of the table in the subquery or the join, e.g. for self-relations
+	On          string // Join condition as trusted SQL, e.g. "authors.id = posts.author_id"
+	Cardinality Cardinality
+}
+
+// qualifier renders the name columns of the related table are qualified with.
+func (r Relation) qualifier(d Dialect) string {
+	if r.Alias != "" {
+		return d.quote(r.Alias)
+	}
+
+	return quotePath(d, r.Table)
+}
+
+// exists renders the start of the EXISTS subquery, the condition and the closing parenthesis follow.
+func (r Relation) exists(d Dialect) string {
+	from := quotePath(d, r.Table)
+	if r.Alias != "" {
+		from += " AS " + d.quote(r.Alias)
+	}
+
+	return "EXISTS (SELECT 1 FROM " + from + " WHERE " + r.On
+}
+
+func (r Relation) validate() error {
+	switch {
+	case r.Cardinality != ToOne && r.Cardinality != ToMany:
+		return fmt.Errorf("unknown cardinality %d", r.Cardinality)
+	case r.On == "":
+		return errors.New("empty join condition")
+	}
+
+	segments, ok := segmentsOf(Identifier(r.Table))
+	if !ok || len(segments) == 0 {
+		return fmt.Errorf("invalid table %q", r.Table)
+	}
+
+	for _, seg := range segments {
+		if seg.Kind == IndexSegment {
+			return fmt.Errorf("invalid table %q", r.Table)
+		}
+	}
+
+	return nil
+}
+
+// quotePath quotes every segment of a validated table path, e.g. "blog"."authors".
+func quotePath(d Dialect, path string) string {
+	segments, _ := segmentsOf(Identifier(path))
+
+	parts := make([]string, 0, len(segments))
+	for _, seg := range segments {
+		parts = append(parts, d.quote(seg.Name))
+	}
+
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,792 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.tomakado.io/dumbql/schema"
+)
+
+// UnknownColumnError is returned by SQLRenderer.ToSql for fields which are neither in Columns nor in Schema.
+type UnknownColumnError struct {
+	Field Identifier
+}
+
+func (e *UnknownColumnError) Error() string {
+	return fmt.Sprintf("field %q is not an allowed column", string(e.Field))
+}
+
+// OneOfMode defines how SQLRenderer binds one-of values.
+type OneOfMode uint8
+
+const (
+	OneOfList  OneOfMode = iota // Every value is bound separately, e.g. `status IN ($1, $2)`, the default
+	OneOfArray                  // Values are bound as a single array, e.g. `status = ANY($1)`, Postgres only
+)
+
+func (m OneOfMode) String() string {
+	switch m {
+	case OneOfList:
+		return "list"
+	case OneOfArray:
+		return "array"
+	default:
+		return "unknown!"
+	}
+}
+
+// SQLRenderer renders expressions into SQL conditions for a specific database. Unlike Expr.ToSql, which
+// produces generic SQL for squirrel, it emits the placeholders of the dialect, quotes every identifier
+// and renders booleans as literals of the dialect, so the condition can be used in a WHERE clause as is:
+//
+//	sql, args, err := query.SQLRenderer{Dialect: query.Postgres}.ToSql(expr)
+//
+// If Columns or Schema is set, only the fields listed there are rendered and any other field fails
+// with UnknownColumnError, so user input never reaches the SQL text as an identifier. Fields are matched
+// exactly: allowing `user` doesn't allow `user.email`.
+type SQLRenderer struct {
+	Dialect Dialect
+
+	Columns map[string]string // Allowed fields and the column paths they are rendered as, e.g. "email": "u.email"
+	Schema  schema.Schema     // Allowed fields rendered under their own name
+
+	Like LikeMode // Where `~` puts the `%` wildcards around the escaped value, `%value%` by default
+
+	// JSONColumns lists the fields holding JSON documents, e.g. "profile". Nested paths like `profile.age`
+	// are extracted from the document, with the extracted value cast to the type of the compared literal.
+	// Whitelisting with Columns and Schema applies to the JSON column, not to the nested paths.
+	// Negative indexes like `profile.tags[-1]` count from the end of the array, except in SQL Server,
+	// which rejects them.
+	JSONColumns []string
+
+	// Relations maps column paths to related tables, e.g. "author" to the authors table, so `author.country`
+	// is rendered as the country column of the related table. The longest matching path wins.
+	// Fields are mapped with Columns before looking up relations.
+	Relations map[string]Relation
+
+	// OneOf defines how one-of values are bound. Binding them as an array keeps the SQL text and
+	// the prepared statement the same regardless of the number of values.
+	OneOf OneOfMode
+
+	// Types declares the SQL types of columns by field. Values compared with integer, UUID and time columns
+	// are bound as int64, [16]byte (a canonical string outside of Postgres) and time.Time, values of other
+	// user-defined types like enums are cast to the type on Postgres, e.g. `$1::mood`.
+	//
+	// Fields of array columns match if any element matches, `@>` checks that the array contains all
+	// of the values. Arrays are supported on Postgres only.
+	Types schema.ColumnTypes
+}
+
+// ToSql renders the expression into an SQL condition and its arguments. Placeholders are numbered from 1.
+//
+// Field paths become quoted column references, e.g. `user.name` is "user"."name" on Postgres.
+// The `~` operator matches values containing the given string, the way StructMatcher does it: the value
+// is wrapped into `%...%` and its LIKE wildcards are escaped. Set Like to match prefixes or suffixes instead.
+// With one-of values `~` matches any of them.
+func (r SQLRenderer) ToSql(expr Expr) (string, []any, error) { //nolint:revive
+	if !r.Dialect.valid() {
+		return "", nil, fmt.Errorf("unknown SQL dialect %d", r.Dialect)
+	}
+
+	if r.OneOf == OneOfArray && r.Dialect != Postgres {
+		return "", nil, fmt.Errorf("%s doesn't support binding one-of values as arrays", r.Dialect)
+	}
+
+	s := &sqlState{SQLRenderer: r}
+	if err := s.prepare(); err != nil {
+		return "", nil, err
+	}
+
+	if err := s.expr(expr); err != nil {
+		return "", nil, err
+	}
+
+	return s.b.String(), s.args, nil
+}
+
+// sqlState holds the output of a single ToSql call.
+type sqlState struct {
+	SQLRenderer
+
+	allowed   map[Identifier]Identifier        // Canonical field paths and their columns, nil if all fields are allowed
+	json      map[Identifier]struct{}          // Canonical paths of JSON columns
+	relations map[Identifier]Relation          // Canonical paths of relations
+	types     map[Identifier]schema.ColumnType // Canonical field paths and their column types
+
+	b    strings.Builder
+	args []any
+}
+
+// prepare collects the canonical paths of the allowed fields, the JSON columns and the relations.
+func (s *sqlState) prepare() error {
+	s.relations = make(map[Identifier]Relation, len(s.Relations))
+
+	for path, rel := range s.Relations {
+		id, err := canonicalIdentifier(path)
+		if err != nil {
+			return fmt.Errorf("relations: %w", err)
+		}
+
+		if err := rel.validate(); err != nil {
+			return fmt.Errorf("relations: %q: %w", path, err)
+		}
+
+		s.relations[id] = rel
+	}
+
+	s.types = make(map[Identifier]schema.ColumnType, len(s.Types))
+
+	for field, typ := range s.Types {
+		id, err := canonicalIdentifier(string(field))
+		if err != nil {
+			return fmt.Errorf("types: %w", err)
+		}
+
+		if typ.Array && s.Dialect != Postgres {
+			return fmt.Errorf("types: field %q: %s doesn't support array columns", field, s.Dialect)
+		}
+
+		s.types[id] = typ
+	}
+
+	s.json = make(map[Identifier]struct{}, len(s.JSONColumns))
+
+	for _, column := range s.JSONColumns {
+		id, err := canonicalIdentifier(column)
+		if err != nil {
+			return fmt.Errorf("json columns: %w", err)
+		}
+		s.json[id] = struct{}{}
+	}
+
+	if s.Columns == nil && s.Schema == nil {
+		return nil
+	}
+
+	s.allowed = make(map[Identifier]Identifier, len(s.Columns)+len(s.Schema))
+
+	for field := range s.Schema {
+		id, err := canonicalIdentifier(string(field))
+		if err != nil {
+			return fmt.Errorf("schema: %w", err)
+		}
+		s.allowed[id] = id
+	}
+
+	for field, column := range s.Columns {
+		id, err := canonicalIdentifier(field)
+		if err != nil {
+			return fmt.Errorf("columns: %w", err)
+		}
+
+		col, err := canonicalIdentifier(column)
+		if err != nil || column == "" {
+			return fmt.Errorf("columns: field %q: invalid column %q", field, column)
+		}
+
+		s.allowed[id] = col
+	}
+
+	return nil
+}
+
+func (s *sqlState) expr(expr Expr) error {
+	if isNil(expr) {
+		return errors.New("nil expression")
+	}
+
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		return s.binary(e)
+	case *NotExpr:
+		s.b.WriteString("NOT (")
+		if err := s.expr(e.Expr); err != nil {
+			return err
+		}
+		s.b.WriteByte(')')
+
+		return nil
+	case *FieldExpr:
+		return s.field(e)
+	default:
+		return fmt.Errorf("unsupported expression %T", expr)
+	}
+}
+
+// binary renders `and` and `or` chains as a single parenthesized list, e.g. (a AND b AND c).
+func (s *sqlState) binary(b *BinaryExpr) error {
+	var sep string
+
+	switch b.Op {
+	case And:
+		sep = " AND "
+	case Or:
+		sep = " OR "
+	default:
+		return fmt.Errorf("unknown operator %q", b.Op)
+	}
+
+	s.b.WriteByte('(')
+
+	for i, operand := range junction(b, b.Op) {
+		if i > 0 {
+			s.b.WriteString(sep)
+		}
+
+		if err := s.expr(operand); err != nil {
+			return err
+		}
+	}
+
+	s.b.WriteByte(')')
+
+	return nil
+}
+
+var sqlOperators = map[FieldOperator]string{
+	Equal:              "=",
+	NotEqual:           "<>",
+	GreaterThan:        ">",
+	GreaterThanOrEqual: ">=",
+	LessThan:           "<",
+	LessThanOrEqual:    "<=",
+}
+
+func (s *sqlState) field(f *FieldExpr) error {
+	lhs, err := s.operand(f)
+	if err != nil {
+		return err
+	}
+
+	rel := lhs.relation
+	if rel == nil || (rel.Cardinality == ToOne && lhs.sql != "") {
+		return s.condition(f, lhs)
+	}
+
+	s.b.WriteString(rel.exists(s.Dialect))
+
+	if lhs.sql != "" {
+		s.b.WriteString(" AND ")
+		if err := s.condition(f, lhs); err != nil {
+			return err
+		}
+	}
+
+	s.b.WriteByte(')')
+
+	return nil
+}
+
+// condition renders the comparison of the field.
+func (s *sqlState) condition(f *FieldExpr, lhs sqlOperand) error {
+	if f.Op == Exists {
+		s.b.WriteString(lhs.sql + " IS NOT NULL")
+		return nil
+	}
+
+	oneOf, isOneOf := f.Value.(*OneOfExpr)
+	if isOneOf {
+		for _, v := range oneOf.Values {
+			if _, nested := v.(*OneOfExpr); nested {
+				return fmt.Errorf("field %q: nested one-of values are not supported", f.Field)
+			}
+		}
+	}
+
+	if lhs.typ.Array {
+		return s.arrayCondition(f, lhs)
+	}
+
+	switch {
+	case f.Op == Contains:
+		return fmt.Errorf("field %q: operator %q requires an array column", f.Field, f.Op)
+	case f.Op == Like && !isOneOf:
+		s.like(lhs.sql, f.Value)
+	case f.Op == Like:
+		return s.likeAny(lhs.sql, oneOf)
+	case isOneOf && (f.Op == Equal || f.Op == NotEqual):
+		return s.oneOf(lhs, f.Op, oneOf)
+	case isOneOf:
+		return fmt.Errorf("field %q: operator %q doesn't support one-of values", f.Field, f.Op)
+	default:
+		op, ok := sqlOperators[f.Op]
+		if !ok {
+			return fmt.Errorf("field %q: unknown operator %q", f.Field, f.Op)
+		}
+
+		s.b.WriteString(lhs.sql + " " + op + " ")
+
+		return s.value(f.Value, lhs)
+	}
+
+	return nil
+}
+
+// sqlOperand is the left-hand side of a field comparison.
+type sqlOperand struct {
+	sql      string
+	field    Identifier
+	json     bool              // Whether the operand is extracted from a JSON column
+	typ      schema.ColumnType // Declared type of the column
+	relation *Relation         // Relation the column belongs to, the operand is empty if the field is the relation itself
+}
+
+func (s *sqlState) operand(f *FieldExpr) (sqlOperand, error) {
+	column, err := s.column(f.Field)
+	if err != nil {
+		return sqlOperand{}, err
+	}
+
+	if column.sql == "" && f.Op != Exists {
+		return sqlOperand{}, fmt.Errorf("field %q: relations only support the presence check", f.Field)
+	}
+
+	if f.Op != Exists && isNil(f.Value) {
+		return sqlOperand{}, fmt.Errorf("field %q: nil value", f.Field)
+	}
+
+	id, _ := canonicalIdentifier(string(f.Field))
+	colType := s.types[id]
+
+	if f.Op == Like && sqlKindOf(colType.SQL) != anyKind {
+		return sqlOperand{}, fmt.Errorf("field %q: operator %q doesn't support %s columns", f.Field, f.Op, colType.SQL)
+	}
+
+	if column.path == nil {
+		return sqlOperand{sql: column.sql, field: f.Field, typ: colType, relation: column.relation}, nil
+	}
+
+	if colType != (schema.ColumnType{}) {
+		return sqlOperand{}, fmt.Errorf("field %q: types of paths nested into JSON columns are not supported", f.Field)
+	}
+
+	path := column.path
+
+	typ := textType
+	if f.Op != Exists && f.Op != Like {
+		if typ, err = literalTypeOf(f.Value); err != nil {
+			return sqlOperand{}, fmt.Errorf("field %q: %w", f.Field, err)
+		}
+	}
+
+	extract, err := s.Dialect.jsonExtract(column.sql, path, typ)
+	if err != nil {
+		return sqlOperand{}, fmt.Errorf("field %q: %w", f.Field, err)
+	}
+
+	return sqlOperand{sql: extract, field: f.Field, json: true, relation: column.relation}, nil
+}
+
+func (s *sqlState) like(column string, v Valuer) {
+	s.b.WriteString(column + " LIKE ")
+	s.arg(s.Like.pattern(s.Dialect.escapeLike(fmt.Sprint(v.Value()))))
+	s.b.WriteString(" ESCAPE '" + string(s.Dialect.likeEscape()) + "'")
+}
+
+// likeAny renders `~` with one-of values as a disjunction. An empty list never matches.
+func (s *sqlState) likeAny(column string, oneOf *OneOfExpr) error {
+	if len(oneOf.Values) == 0 {
+		s.b.WriteString("1=0")
+		return nil
+	}
+
+	s.b.WriteByte('(')
+
+	for i, v := range oneOf.Values {
+		if isNil(v) {
+			return errors.New("nil one-of value")
+		}
+
+		if i > 0 {
+			s.b.WriteString(" OR ")
+		}
+
+		s.like(column, v)
+	}
+
+	s.b.WriteByte(')')
+
+	return nil
+}
+
+// oneOf renders `IN` lists or array comparisons. An empty list never matches with `=` and always matches with `!=`.
+func (s *sqlState) oneOf(lhs sqlOperand, op FieldOperator, oneOf *OneOfExpr) error {
+	if len(oneOf.Values) == 0 {
+		if op == Equal {
+			s.b.WriteString("1=0")
+		} else {
+			s.b.WriteString("1=1")
+		}
+
+		return nil
+	}
+
+	if s.OneOf == OneOfArray {
+		return s.oneOfArray(lhs, op, oneOf)
+	}
+
+	s.b.WriteString(lhs.sql)
+	if op == NotEqual {
+		s.b.WriteString(" NOT")
+	}
+	s.b.WriteString(" IN (")
+
+	for i, v := range oneOf.Values {
+		if isNil(v) {
+			return errors.New("nil one-of value")
+		}
+
+		if i > 0 {
+			s.b.WriteString(", ")
+		}
+
+		if err := s.value(v, lhs); err != nil {
+			return err
+		}
+	}
+
+	s.b.WriteByte(')')
+
+	return nil
+}
+
+// oneOfArray renders `= ANY($1)` or `<> ALL($1)` with the values bound as a typed slice, see arrayArg.
+func (s *sqlState) oneOfArray(lhs sqlOperand, op FieldOperator, oneOf *OneOfExpr) error {
+	arr, err := s.arrayArg(oneOf.Values, lhs.typ.SQL)
+	if err != nil {
+		return fmt.Errorf("field %q: %w", lhs.field, err)
+	}
+
+	if op == Equal {
+		s.b.WriteString(lhs.sql + " = ANY(")
+	} else {
+		s.b.WriteString(lhs.sql + " <> ALL(")
+	}
+
+	s.arg(arr)
+	s.b.WriteString(s.Dialect.cast(lhs.typ.SQL, true) + ")")
+
+	return nil
+}
+
+// arrayOperators are the operators comparing a value with the elements of an array column, flipped
+// since the value comes first: `tags>a` is rendered as `$1 < ANY("tags")`.
+var arrayOperators = map[FieldOperator]string{
+	Equal:              "=",
+	NotEqual:           "<>",
+	GreaterThan:        "<",
+	GreaterThanOrEqual: "<=",
+	LessThan:           ">",
+	LessThanOrEqual:    ">=",
+}
+
+// arrayCondition renders the comparison of an array column, matching the way StructMatcher matches slices:
+//
+//	tags:a          $1 = ANY("tags")
+//	tags!=a         $1 <> ALL("tags")
+//	tags>a          $1 < ANY("tags")
+//	tags~a          EXISTS (SELECT 1 FROM unnest("tags") AS elem WHERE elem LIKE $1 ESCAPE '\')
+//	tags:[a, b]     "tags" && $1
+//	tags!=[a, b]    NOT ("tags" && $1)
+//	tags @> [a, b]  "tags" @> $1
+//
+// Arrays of values are bound as typed slices, see arrayArg.
+func (s *sqlState) arrayCondition(f *FieldExpr, lhs sqlOperand) error {
+	column := lhs.sql
+	oneOf, isOneOf := f.Value.(*OneOfExpr)
+
+	switch {
+	case f.Op == Like:
+		s.b.WriteString("EXISTS (SELECT 1 FROM unnest(" + column + ") AS elem WHERE ")
+
+		if isOneOf {
+			if err := s.likeAny("elem", oneOf); err != nil {
+				return err
+			}
+		} else {
+			s.like("elem", f.Value)
+		}
+
+		s.b.WriteByte(')')
+	case f.Op == Contains || (isOneOf && (f.Op == Equal || f.Op == NotEqual)):
+		return s.arrayOverlap(f, lhs)
+	case isOneOf:
+		return fmt.Errorf("field %q: operator %q doesn't support one-of values", f.Field, f.Op)
+	default:
+		op, ok := arrayOperators[f.Op]
+		if !ok {
+			return fmt.Errorf("field %q: unknown operator %q", f.Field, f.Op)
+		}
+
+		if err := s.value(f.Value, lhs); err != nil {
+			return err
+		}
+
+		if f.Op == NotEqual {
+			s.b.WriteString(" " + op + " ALL(" + column + ")")
+		} else {
+			s.b.WriteString(" " + op + " ANY(" + column + ")")
+		}
+	}
+
+	return nil
+}
+
+// arrayOverlap renders the overlap and containment checks of an array column. An empty list never
+// overlaps and is contained in every array.
+func (s *sqlState) arrayOverlap(f *FieldExpr, lhs sqlOperand) error {
+	values := equalityValues(f.Value)
+
+	if len(values) == 0 {
+		if f.Op == Equal {
+			s.b.WriteString("1=0")
+		} else {
+			s.b.WriteString("1=1")
+		}
+
+		return nil
+	}
+
+	arr, err := s.arrayArg(values, lhs.typ.SQL)
+	if err != nil {
+		return fmt.Errorf("field %q: %w", f.Field, err)
+	}
+
+	cast := s.Dialect.cast(lhs.typ.SQL, true)
+
+	switch f.Op { //nolint:exhaustive
+	case Equal:
+		s.b.WriteString(lhs.sql + " && ")
+		s.arg(arr)
+		s.b.WriteString(cast)
+	case NotEqual:
+		s.b.WriteString("NOT (" + lhs.sql + " && ")
+		s.arg(arr)
+		s.b.WriteString(cast + ")")
+	default:
+		s.b.WriteString(lhs.sql + " @> ")
+		s.arg(arr)
+		s.b.WriteString(cast)
+	}
+
+	return nil
+}
+
+// literalType defines the SQL type literals are compared and bound as.
+type literalType uint8
+
+const (
+	textType literalType = iota
+	numberType
+	boolType
+)
+
+// literalTypeOf returns the type of the literal, one-of values must share the same type.
+func literalTypeOf(v Valuer) (literalType, error) {
+	switch val := v.(type) {
+	case *NumberLiteral:
+		return numberType, nil
+	case *BoolLiteral:
+		return boolType, nil
+	case *OneOfExpr:
+		if len(val.Values) == 0 {
+			return textType, nil
+		}
+
+		typ, err := literalTypeOf(val.Values[0])
+		if err != nil {
+			return 0, err
+		}
+
+		for _, item := range val.Values[1:] {
+			if t, err := literalTypeOf(item); err != nil || t != typ {
+				return 0, errors.New("one-of values must be of the same type")
+			}
+		}
+
+		return typ, nil
+	default:
+		if isNil(v) {
+			return 0, errors.New("nil value")
+		}
+
+		return textType, nil
+	}
+}
+
+// arrayArg converts the values to a slice of the Go type bound for the SQL type: []int64, [][16]byte,
+// []time.Time or []string for user-defined types. Values of other columns are converted to a slice of
+// their common type: []string, []float64 or []bool.
+func (s *sqlState) arrayArg(values []Valuer, sqlType string) (any, error) {
+	switch sqlKindOf(sqlType) {
+	case integerKind:
+		return convertArray[int64](s.Dialect, values, sqlType)
+	case uuidKind:
+		if s.Dialect == Postgres {
+			return convertArray[[16]byte](s.Dialect, values, sqlType)
+		}
+
+		return convertArray[string](s.Dialect, values, sqlType)
+	case timeKind:
+		return convertArray[time.Time](s.Dialect, values, sqlType)
+	case castKind:
+		return convertArray[string](s.Dialect, values, sqlType)
+	case anyKind:
+	}
+
+	typ, err := literalTypeOf(&OneOfExpr{Values: values})
+	if err != nil {
+		return nil, err
+	}
+
+	switch typ {
+	case numberType:
+		return arrayOf[float64](values)
+	case boolType:
+		return arrayOf[bool](values)
+	default:
+		return arrayOf[string](values)
+	}
+}
+
+func convertArray[T any](d Dialect, values []Valuer, sqlType string) ([]T, error) {
+	arr := make([]T, 0, len(values))
+
+	for _, v := range values {
+		if isNil(v) {
+			return nil, errors.New("nil one-of value")
+		}
+
+		val, err := d.convertValue(sqlType, v)
+		if err != nil {
+			return nil, err
+		}
+
+		arr = append(arr, val.(T))
+	}
+
+	return arr, nil
+}
+
+// arrayOf collects the values into a slice, values of other types than T are reported as errors.
+func arrayOf[T any](values []Valuer) ([]T, error) {
+	arr := make([]T, 0, len(values))
+
+	for _, v := range values {
+		val, ok := v.Value().(T)
+		if !ok {
+			return nil, fmt.Errorf("one-of value %v is not of type %T", v.Value(), *new(T))
+		}
+
+		arr = append(arr, val)
+	}
+
+	return arr, nil
+}
+
+// value renders the value as a placeholder, booleans are rendered as literals. Values compared with
+// typed columns are converted to the Go type of the column or cast to it.
+func (s *sqlState) value(v Valuer, lhs sqlOperand) error {
+	if sqlKindOf(lhs.typ.SQL) != anyKind {
+		arg, err := s.Dialect.convertValue(lhs.typ.SQL, v)
+		if err != nil {
+			return fmt.Errorf("field %q: %w", lhs.field, err)
+		}
+
+		s.arg(arg)
+		s.b.WriteString(s.Dialect.cast(lhs.typ.SQL, false))
+
+		return nil
+	}
+
+	if b, ok := v.(*BoolLiteral); ok && lhs.json {
+		s.b.WriteString(s.Dialect.jsonBoolean(b.BoolValue))
+		return nil
+	}
+
+	if b, ok := v.(*BoolLiteral); ok {
+		s.b.WriteString(s.Dialect.boolean(b.BoolValue))
+		return nil
+	}
+
+	s.arg(v.Value())
+
+	return nil
+}
+
+func (s *sqlState) arg(v any) {
+	s.args = append(s.args, v)
+	s.b.WriteString(s.Dialect.placeholder(len(s.args)))
+}
+
+// sqlColumn is a column reference resolved from a field path.
+type sqlColumn struct {
+	sql      string    // Quoted column, empty if the field is the relation itself
+	path     []Segment // Path inside of the JSON column, nil for regular columns
+	relation *Relation // Relation the column belongs to
+}
+
+// column renders the field path as a quoted column reference. If the path is nested into a JSON column,
+// the rest of the path is returned separately. Index segments have no column counterpart and are rejected.
+func (s *sqlState) column(field Identifier) (sqlColumn, error) {
+	segments, ok := segmentsOf(field)
+	if !ok {
+		segments = nil
+	}
+
+	var col sqlColumn
+
+	for n := len(segments) - 1; n > 0; n-- {
+		if _, isJSON := s.json[joinSegments(segments[:n])]; isJSON {
+			segments, col.path = segments[:n], segments[n:]
+			break
+		}
+	}
+
+	if s.allowed != nil {
+		column, allowed := s.allowed[joinSegments(segments)]
+		if !ok || !allowed {
+			return sqlColumn{}, &UnknownColumnError{Field: field}
+		}
+
+		segments, ok = segmentsOf(column)
+	}
+
+	if !ok || len(segments) == 0 {
+		return sqlColumn{}, fmt.Errorf("invalid field %q", string(field))
+	}
+
+	var parts []string
+
+	for n := len(segments); n > 0; n-- {
+		if rel, isRelation := s.relations[joinSegments(segments[:n])]; isRelation {
+			col.relation = &rel
+			parts = append(parts, rel.qualifier(s.Dialect))
+			segments = segments[n:]
+
+			break
+		}
+	}
+
+	if col.relation != nil && len(segments) == 0 {
+		if col.path != nil {
+			return sqlColumn{}, fmt.Errorf("field %q: relation can't be a JSON column", string(field))
+		}
+
+		return col, nil
+	}
+
+	for _, seg := range segments {
+		if seg.Kind == IndexSegment {
+			return sqlColumn{}, fmt.Errorf("field %q: index segments are not supported in SQL", string(field))
+		}
+
+		parts = append(parts, s.Dialect.quote(seg.Name))
+	}
+
+	col.sql = strings.Join(parts, ".")
+
+	return col, nil
+}
@@ -0,0 +1,774 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+	"go.tomakado.io/dumbql/schema"
+)
+
+func TestSQLRenderer(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		input    string
+		dialect  query.Dialect
+		want     string
+		wantArgs []any
+	}{
+		{
+			input:    `status:200 and order>=2`,
+			dialect:  query.Postgres,
+			want:     `("status" = $1 AND "order" >= $2)`,
+			wantArgs: []any{float64(200), float64(2)},
+		},
+		{
+			input:    `status:200 and order>=2`,
+			dialect:  query.MySQL,
+			want:     "(`status` = ? AND `order` >= ?)",
+			wantArgs: []any{float64(200), float64(2)},
+		},
+		{
+			input:    `status:200 and order>=2`,
+			dialect:  query.SQLite,
+			want:     `("status" = ? AND "order" >= ?)`,
+			wantArgs: []any{float64(200), float64(2)},
+		},
+		{
+			input:    `status:200 and order>=2`,
+			dialect:  query.SQLServer,
+			want:     `([status] = @p1 AND [order] >= @p2)`,
+			wantArgs: []any{float64(200), float64(2)},
+		},
+		{
+			input:    `a:1 and b:2 and (c:3 or d!=4 or e<5) and not f<=6`,
+			dialect:  query.Postgres,
+			want:     `("a" = $1 AND "b" = $2 AND ("c" = $3 OR "d" <> $4 OR "e" < $5) AND NOT ("f" <= $6))`,
+			wantArgs: []any{float64(1), float64(2), float64(3), float64(4), float64(5), float64(6)},
+		},
+		{
+			input:   `verified and not deleted:false`,
+			dialect: query.Postgres,
+			want:    `("verified" = TRUE AND NOT ("deleted" = FALSE))`,
+		},
+		{
+			input:   `verified and not deleted:false`,
+			dialect: query.SQLServer,
+			want:    `([verified] = 1 AND NOT ([deleted] = 0))`,
+		},
+		{
+			input:    `user.name:"John" and labels.` + "`app/name`" + `:web and attrs["a\"b"]:x`,
+			dialect:  query.Postgres,
+			want:     `("user"."name" = $1 AND "labels"."app/name" = $2 AND "attrs"."a""b" = $3)`,
+			wantArgs: []any{"John", "web", "x"},
+		},
+		{
+			input:    "`we]ird`:1",
+			dialect:  query.SQLServer,
+			want:     `[we]]ird] = @p1`,
+			wantArgs: []any{float64(1)},
+		},
+		{
+			input:    "a[\"we`ird\"]:1",
+			dialect:  query.MySQL,
+			want:     "`a`.`we``ird` = ?",
+			wantArgs: []any{float64(1)},
+		},
+		{
+			input:    `name~"50%_off\\"`,
+			dialect:  query.Postgres,
+			want:     `"name" LIKE $1 ESCAPE '\'`,
+			wantArgs: []any{`%50\%\_off\\%`},
+		},
+		{
+			input:    `name~"50%_off!"`,
+			dialect:  query.MySQL,
+			want:     "`name` LIKE ? ESCAPE '!'",
+			wantArgs: []any{`%50!%!_off!!%`},
+		},
+		{
+			input:    `name~"[a]"`,
+			dialect:  query.SQLServer,
+			want:     `[name] LIKE @p1 ESCAPE '\'`,
+			wantArgs: []any{`%\[a]%`},
+		},
+		{
+			input:    `code~42`,
+			dialect:  query.SQLite,
+			want:     `"code" LIKE ? ESCAPE '\'`,
+			wantArgs: []any{`%42%`},
+		},
+		{
+			input:   `email?`,
+			dialect: query.Postgres,
+			want:    `"email" IS NOT NULL`,
+		},
+		{
+			input:    `status:[new, 2, true] and not kind:[]`,
+			dialect:  query.Postgres,
+			want:     `("status" IN ($1, $2, TRUE) AND NOT (1=0))`,
+			wantArgs: []any{"new", float64(2)},
+		},
+		{
+			input:    `status!=[new, old] or kind!=[]`,
+			dialect:  query.SQLServer,
+			want:     `([status] NOT IN (@p1, @p2) OR 1=1)`,
+			wantArgs: []any{"new", "old"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.dialect.String()+" "+test.input, func(t *testing.T) {
+			sql, args, err := query.SQLRenderer{Dialect: test.dialect}.ToSql(mustParse(t, test.input))
+			require.NoError(t, err)
+			assert.Equal(t, test.want, sql)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+}
+
+func TestSQLRenderer_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect query.Dialect
+		expr    query.Expr
+	}{
+		{name: "unknown dialect", dialect: 0, expr: mustParse(t, `a:1`)},
+		{name: "nil", dialect: query.Postgres, expr: nil},
+		{name: "nil operand", dialect: query.Postgres, expr: &query.NotExpr{}},
+		{name: "nil value", dialect: query.Postgres, expr: &query.FieldExpr{Field: "a", Op: query.Equal}},
+		{name: "index segment", dialect: query.Postgres, expr: mustParse(t, `items[0]:1`)},
+		{name: "invalid field", dialect: query.Postgres, expr: &query.FieldExpr{Field: "a[", Value: &query.BoolLiteral{}}},
+		{
+			name:    "unknown operator",
+			dialect: query.Postgres,
+			expr:    &query.FieldExpr{Field: "a", Op: 42, Value: &query.BoolLiteral{}},
+		},
+		{name: "one-of comparison", dialect: query.Postgres, expr: mustParse(t, `a>[1, 2]`)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := query.SQLRenderer{Dialect: test.dialect}.ToSql(test.expr)
+			require.Error(t, err)
+		})
+	}
+}
+
+// intValuer is a non-literal value whose Value isn't of any type produced by the parser.
+type intValuer int
+
+func (v intValuer) Value() any                          { return int(v) }
+func (v intValuer) Match(any, query.FieldOperator) bool { return false }
+
+func TestSQLRenderer_OneOfErrors(t *testing.T) {
+	nested := &query.FieldExpr{Field: "a", Op: query.Equal, Value: &query.OneOfExpr{
+		Values: []query.Valuer{&query.OneOfExpr{Values: []query.Valuer{query.Identifier("x")}}},
+	}}
+	custom := &query.FieldExpr{Field: "a", Op: query.Equal, Value: &query.OneOfExpr{
+		Values: []query.Valuer{intValuer(1)},
+	}}
+
+	tests := []struct {
+		name     string
+		renderer query.SQLRenderer
+		expr     query.Expr
+	}{
+		{name: "nested list", renderer: query.SQLRenderer{Dialect: query.Postgres}, expr: nested},
+		{
+			name:     "nested array",
+			renderer: query.SQLRenderer{Dialect: query.Postgres, OneOf: query.OneOfArray},
+			expr:     nested,
+		},
+		{
+			name:     "nested like",
+			renderer: query.SQLRenderer{Dialect: query.Postgres},
+			expr:     &query.FieldExpr{Field: "a", Op: query.Like, Value: nested.Value},
+		},
+		{
+			name: "nested array column",
+			renderer: query.SQLRenderer{
+				Dialect: query.Postgres,
+				Types:   schema.ColumnTypes{"a": {SQL: "text", Array: true}},
+			},
+			expr: nested,
+		},
+		{
+			name:     "custom value in array",
+			renderer: query.SQLRenderer{Dialect: query.Postgres, OneOf: query.OneOfArray},
+			expr:     custom,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.NotPanics(t, func() {
+				_, _, err := test.renderer.ToSql(test.expr)
+				require.Error(t, err)
+			})
+		})
+	}
+}
+
+func TestDialect_String(t *testing.T) {
+	assert.Equal(t, "postgres", query.Postgres.String())
+	assert.Equal(t, "mysql", query.MySQL.String())
+	assert.Equal(t, "sqlite", query.SQLite.String())
+	assert.Equal(t, "sqlserver", query.SQLServer.String())
+	assert.Equal(t, "unknown!", query.Dialect(0).String())
+
+	assert.Equal(t, "to-one", query.ToOne.String())
+	assert.Equal(t, "to-many", query.ToMany.String())
+	assert.Equal(t, "unknown!", query.Cardinality(0).String())
+}
+
+func TestSQLRenderer_Columns(t *testing.T) { //nolint:funlen
+	renderer := query.SQLRenderer{
+		Dialect: query.Postgres,
+		Columns: map[string]string{
+			"email":           "u.email",
+			"`full name`":     "u.name",
+			`order["status"]`: "o.`status`",
+		},
+		Schema: schema.Schema{
+			"age":    schema.Any(),
+			"orders": schema.Any(),
+		},
+	}
+
+	t.Run("allowed", func(t *testing.T) {
+		tests := []struct {
+			input string
+			want  string
+		}{
+			{input: `email:a`, want: `"u"."email" = $1`},
+			{input: "`full name`:a", want: `"u"."name" = $1`},
+			{input: `order["status"]:a`, want: `"o"."status" = $1`},
+			{input: `age>18 and orders?`, want: `("age" > $1 AND "orders" IS NOT NULL)`},
+		}
+
+		for _, test := range tests {
+			t.Run(test.input, func(t *testing.T) {
+				sql, _, err := renderer.ToSql(mustParse(t, test.input))
+				require.NoError(t, err)
+				assert.Equal(t, test.want, sql)
+			})
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		tests := []struct {
+			expr  query.Expr
+			field query.Identifier
+		}{
+			{expr: mustParse(t, `age>18 and password:x`), field: "password"},
+			{expr: mustParse(t, `email.domain:x`), field: "email.domain"},
+			{expr: mustParse(t, `order:x`), field: "order"},
+			{expr: mustParse(t, `order.status:x`), field: "order.status"},
+			{
+				expr:  &query.FieldExpr{Field: "1=1 or x", Op: query.Equal, Value: &query.BoolLiteral{}},
+				field: "1=1 or x",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(string(test.field), func(t *testing.T) {
+				_, _, err := renderer.ToSql(test.expr)
+
+				var columnErr *query.UnknownColumnError
+				require.ErrorAs(t, err, &columnErr)
+				assert.Equal(t, test.field, columnErr.Field)
+			})
+		}
+	})
+
+	t.Run("empty whitelist", func(t *testing.T) {
+		_, _, err := query.SQLRenderer{Dialect: query.MySQL, Columns: map[string]string{}}.ToSql(mustParse(t, `a:1`))
+
+		var columnErr *query.UnknownColumnError
+		require.ErrorAs(t, err, &columnErr)
+	})
+
+	t.Run("invalid columns", func(t *testing.T) {
+		for _, columns := range []map[string]string{{"a[": "a"}, {"a": "a["}, {"a": ""}} {
+			_, _, err := query.SQLRenderer{Dialect: query.MySQL, Columns: columns}.ToSql(mustParse(t, `a:1`))
+			require.Error(t, err)
+		}
+	})
+}
+
+func TestSQLRenderer_Like(t *testing.T) {
+	tests := []struct {
+		input    string
+		mode     query.LikeMode
+		want     string
+		wantArgs []any
+	}{
+		{input: `name~jo`, mode: query.LikeContains, want: `"name" LIKE $1 ESCAPE '\'`, wantArgs: []any{"%jo%"}},
+		{input: `name~jo`, mode: query.LikePrefix, want: `"name" LIKE $1 ESCAPE '\'`, wantArgs: []any{"jo%"}},
+		{input: `name~"j_"`, mode: query.LikeSuffix, want: `"name" LIKE $1 ESCAPE '\'`, wantArgs: []any{`%j\_`}},
+		{
+			input:    `name~[jo, an]`,
+			mode:     query.LikePrefix,
+			want:     `("name" LIKE $1 ESCAPE '\' OR "name" LIKE $2 ESCAPE '\')`,
+			wantArgs: []any{"jo%", "an%"},
+		},
+		{input: `name~[]`, want: `1=0`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mode.String()+" "+test.input, func(t *testing.T) {
+			renderer := query.SQLRenderer{Dialect: query.Postgres, Like: test.mode}
+
+			sql, args, err := renderer.ToSql(mustParse(t, test.input))
+			require.NoError(t, err)
+			assert.Equal(t, test.want, sql)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+}
+
+func TestLikeMode(t *testing.T) {
+	assert.True(t, query.LikeContains.Match("hello world", "o w"))
+	assert.True(t, query.LikePrefix.Match("hello world", "hello"))
+	assert.False(t, query.LikePrefix.Match("hello world", "world"))
+	assert.True(t, query.LikeSuffix.Match("hello world", "world"))
+	assert.False(t, query.LikeSuffix.Match("hello world", "hello"))
+
+	assert.Equal(t, "contains", query.LikeContains.String())
+	assert.Equal(t, "prefix", query.LikePrefix.String())
+	assert.Equal(t, "suffix", query.LikeSuffix.String())
+	assert.Equal(t, "unknown!", query.LikeMode(42).String())
+}
+
+func TestSQLRenderer_JSONColumns(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		input    string
+		dialect  query.Dialect
+		want     string
+		wantArgs []any
+	}{
+		{
+			input:    `profile.age>18 and profile.address.city:BCN`,
+			dialect:  query.Postgres,
+			want:     `(("profile"->>'age')::numeric > $1 AND ("profile"->'address'->>'city') = $2)`,
+			wantArgs: []any{float64(18), "BCN"},
+		},
+		{
+			input:   `profile.age>18 and profile.address.city:BCN`,
+			dialect: query.MySQL,
+			want: "(CAST(JSON_EXTRACT(`profile`, '$.age') AS DOUBLE) > ? AND " +
+				"JSON_UNQUOTE(JSON_EXTRACT(`profile`, '$.address.city')) = ?)",
+			wantArgs: []any{float64(18), "BCN"},
+		},
+		{
+			input:    `profile.age>18 and profile.address.city:BCN`,
+			dialect:  query.SQLite,
+			want:     `(json_extract("profile", '$.age') > ? AND json_extract("profile", '$.address.city') = ?)`,
+			wantArgs: []any{float64(18), "BCN"},
+		},
+		{
+			input:    `profile.age>18 and profile.address.city:BCN`,
+			dialect:  query.SQLServer,
+			want:     `(CAST(JSON_VALUE([profile], '$.age') AS float) > @p1 AND JSON_VALUE([profile], '$.address.city') = @p2)`,
+			wantArgs: []any{float64(18), "BCN"},
+		},
+		{
+			input:   `profile.verified`,
+			dialect: query.Postgres,
+			want:    `("profile"->>'verified')::boolean = TRUE`,
+		},
+		{
+			input:   `profile.verified`,
+			dialect: query.MySQL,
+			want:    "JSON_EXTRACT(`profile`, '$.verified') = CAST(TRUE AS JSON)",
+		},
+		{
+			input:   `profile.verified`,
+			dialect: query.SQLite,
+			want:    `json_extract("profile", '$.verified') = 1`,
+		},
+		{
+			input:   `profile.verified:false`,
+			dialect: query.SQLServer,
+			want:    `JSON_VALUE([profile], '$.verified') = 'false'`,
+		},
+		{
+			input:   `profile.tags[0]:[a, b] and profile["it's"]~x and profile.email?`,
+			dialect: query.Postgres,
+			want: `(("profile"->'tags'->>0) IN ($1, $2) AND ("profile"->>'it''s') LIKE $3 ESCAPE '\' AND ` +
+				`("profile"->>'email') IS NOT NULL)`,
+			wantArgs: []any{"a", "b", "%x%"},
+		},
+		{
+			input:    "profile.tags[0]:[1, 2] and profile.`zip code`:x",
+			dialect:  query.SQLite,
+			want:     `(json_extract("profile", '$.tags[0]') IN (?, ?) AND json_extract("profile", '$."zip code"') = ?)`,
+			wantArgs: []any{float64(1), float64(2), "x"},
+		},
+		{
+			// Negative indexes count from the end of the array.
+			input:    `profile.tags[-1]:a`,
+			dialect:  query.Postgres,
+			want:     `("profile"->'tags'->>-1) = $1`,
+			wantArgs: []any{"a"},
+		},
+		{
+			input:   `profile.tags[-1]:a and profile.tags[-3]:b`,
+			dialect: query.MySQL,
+			want: "(JSON_UNQUOTE(JSON_EXTRACT(`profile`, '$.tags[last]')) = ? AND " +
+				"JSON_UNQUOTE(JSON_EXTRACT(`profile`, '$.tags[last-2]')) = ?)",
+			wantArgs: []any{"a", "b"},
+		},
+		{
+			input:    `profile.tags[-1]:a`,
+			dialect:  query.SQLite,
+			want:     `json_extract("profile", '$.tags[#-1]') = ?`,
+			wantArgs: []any{"a"},
+		},
+		{
+			input:    `data.meta.score>=1 and profile:x`,
+			dialect:  query.Postgres,
+			want:     `(("data"."meta"->>'score')::numeric >= $1 AND "profile" = $2)`,
+			wantArgs: []any{float64(1), "x"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.dialect.String()+" "+test.input, func(t *testing.T) {
+			renderer := query.SQLRenderer{Dialect: test.dialect, JSONColumns: []string{"profile", "data.meta"}}
+
+			sql, args, err := renderer.ToSql(mustParse(t, test.input))
+			require.NoError(t, err)
+			assert.Equal(t, test.want, sql)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+}
+
+func TestSQLRenderer_JSONColumns_Errors(t *testing.T) {
+	renderer := query.SQLRenderer{
+		Dialect:     query.MySQL,
+		JSONColumns: []string{"profile"},
+		Columns:     map[string]string{"doc": "d"},
+	}
+
+	tests := []string{
+		`profile.age:[1, x]`,
+		`profile["it's"]:x`,
+		`profile.age:1`, // profile isn't allowed by Columns
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			_, _, err := renderer.ToSql(mustParse(t, input))
+			require.Error(t, err)
+		})
+	}
+
+	_, _, err := query.SQLRenderer{Dialect: query.MySQL, JSONColumns: []string{"a["}}.ToSql(mustParse(t, `a:1`))
+	require.Error(t, err)
+
+	// SQL Server has no way to index arrays from the end.
+	_, _, err = query.SQLRenderer{Dialect: query.SQLServer, JSONColumns: []string{"profile"}}.
+		ToSql(mustParse(t, `profile.tags[-1]:a`))
+	require.ErrorContains(t, err, "negative indexes are not supported")
+
+	renderer.Columns["profile"] = "p"
+	sql, _, err := renderer.ToSql(mustParse(t, `profile.age:1`))
+	require.NoError(t, err)
+	assert.Equal(t, "CAST(JSON_EXTRACT(`p`, '$.age') AS DOUBLE) = ?", sql)
+}
+
+func TestSQLRenderer_Relations(t *testing.T) { //nolint:funlen
+	renderer := query.SQLRenderer{
+		Dialect: query.Postgres,
+		Relations: map[string]query.Relation{
+			"author": {Table: "authors", On: "authors.id = posts.author_id", Cardinality: query.ToOne},
+			"comments": {
+				Table:       "blog.comments",
+				Alias:       "c",
+				On:          "c.post_id = posts.id",
+				Cardinality: query.ToMany,
+			},
+			"comments.author": {Table: "users", On: "users.id = c.author_id", Cardinality: query.ToOne},
+		},
+		JSONColumns: []string{"comments.meta"},
+	}
+
+	tests := []struct {
+		input    string
+		want     string
+		wantArgs []any
+	}{
+		{
+			input:    `author.country:ES and title~go`,
+			want:     `("authors"."country" = $1 AND "title" LIKE $2 ESCAPE '\')`,
+			wantArgs: []any{"ES", "%go%"},
+		},
+		{
+			input: `comments.likes>10 or not comments.spam`,
+			want: `(EXISTS (SELECT 1 FROM "blog"."comments" AS "c" WHERE c.post_id = posts.id AND "c"."likes" > $1)` +
+				` OR NOT (EXISTS (SELECT 1 FROM "blog"."comments" AS "c" WHERE c.post_id = posts.id AND "c"."spam" = TRUE)))`,
+			wantArgs: []any{float64(10)},
+		},
+		{
+			input:    `comments.author.name:[a, b]`,
+			want:     `"users"."name" IN ($1, $2)`,
+			wantArgs: []any{"a", "b"},
+		},
+		{
+			input: `comments?`,
+			want:  `EXISTS (SELECT 1 FROM "blog"."comments" AS "c" WHERE c.post_id = posts.id)`,
+		},
+		{
+			input: `comments.meta.lang:en`,
+			want: `EXISTS (SELECT 1 FROM "blog"."comments" AS "c" WHERE c.post_id = posts.id AND ` +
+				`("c"."meta"->>'lang') = $1)`,
+			wantArgs: []any{"en"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			sql, args, err := renderer.ToSql(mustParse(t, test.input))
+			require.NoError(t, err)
+			assert.Equal(t, test.want, sql)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+
+	t.Run("columns", func(t *testing.T) {
+		r := renderer
+		r.Columns = map[string]string{"writer.country": "author.country"}
+
+		sql, _, err := r.ToSql(mustParse(t, `writer.country:ES`))
+		require.NoError(t, err)
+		assert.Equal(t, `"authors"."country" = $1`, sql)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, _, err := renderer.ToSql(mustParse(t, `comments:x`))
+		require.Error(t, err)
+
+		invalid := []query.Relation{
+			{Table: "authors", On: "authors.id = posts.author_id"},
+			{Table: "authors", Cardinality: query.ToOne},
+			{Table: "authors[0]", On: "true", Cardinality: query.ToOne},
+			{On: "true", Cardinality: query.ToOne},
+		}
+
+		for _, rel := range invalid {
+			r := query.SQLRenderer{Dialect: query.Postgres, Relations: map[string]query.Relation{"author": rel}}
+			_, _, err := r.ToSql(mustParse(t, `a:1`))
+			require.Error(t, err)
+		}
+	})
+}
+
+func TestSQLRenderer_OneOfArray(t *testing.T) {
+	renderer := query.SQLRenderer{Dialect: query.Postgres, OneOf: query.OneOfArray, JSONColumns: []string{"doc"}}
+
+	tests := []struct {
+		input    string
+		want     string
+		wantArgs []any
+	}{
+		{input: `status:[new, old]`, want: `"status" = ANY($1)`, wantArgs: []any{[]string{"new", "old"}}},
+		{input: `status!=[new, old]`, want: `"status" <> ALL($1)`, wantArgs: []any{[]string{"new", "old"}}},
+		{input: `id:[1, 2, 3]`, want: `"id" = ANY($1)`, wantArgs: []any{[]float64{1, 2, 3}}},
+		{input: `flag:[true]`, want: `"flag" = ANY($1)`, wantArgs: []any{[]bool{true}}},
+		{input: `doc.n:[1, 2]`, want: `("doc"->>'n')::numeric = ANY($1)`, wantArgs: []any{[]float64{1, 2}}},
+		{input: `id:[] or id!=[]`, want: `(1=0 OR 1=1)`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			sql, args, err := renderer.ToSql(mustParse(t, test.input))
+			require.NoError(t, err)
+			assert.Equal(t, test.want, sql)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		_, _, err := renderer.ToSql(mustParse(t, `id:[1, x]`))
+		require.Error(t, err)
+
+		_, _, err = query.SQLRenderer{Dialect: query.MySQL, OneOf: query.OneOfArray}.ToSql(mustParse(t, `id:[1]`))
+		require.Error(t, err)
+	})
+
+	assert.Equal(t, "list", query.OneOfList.String())
+	assert.Equal(t, "array", query.OneOfArray.String())
+	assert.Equal(t, "unknown!", query.OneOfMode(42).String())
+}
+
+func TestSQLRenderer_ArrayColumns(t *testing.T) {
+	renderer := query.SQLRenderer{
+		Dialect: query.Postgres,
+		Types:   schema.ColumnTypes{"tags": {Array: true}, "scores": {Array: true}},
+	}
+
+	tests := []struct {
+		input    string
+		want     string
+		wantArgs []any
+	}{
+		{input: `tags:urgent`, want: `$1 = ANY("tags")`, wantArgs: []any{"urgent"}},
+		{input: `tags!=urgent`, want: `$1 <> ALL("tags")`, wantArgs: []any{"urgent"}},
+		{input: `scores>=5`, want: `$1 <= ANY("scores")`, wantArgs: []any{float64(5)}},
+		{input: `tags:[a, b]`, want: `"tags" && $1`, wantArgs: []any{[]string{"a", "b"}}},
+		{input: `tags!=[a, b]`, want: `NOT ("tags" && $1)`, wantArgs: []any{[]string{"a", "b"}}},
+		{input: `tags @> [a, b]`, want: `"tags" @> $1`, wantArgs: []any{[]string{"a", "b"}}},
+		{input: `tags @> a`, want: `"tags" @> $1`, wantArgs: []any{[]string{"a"}}},
+		{input: `scores @> [1, 2]`, want: `"scores" @> $1`, wantArgs: []any{[]float64{1, 2}}},
+		{input: `(tags:[] or tags @> [])`, want: `(1=0 OR 1=1)`},
+		{
+			input:    `tags~"50%"`,
+			want:     `EXISTS (SELECT 1 FROM unnest("tags") AS elem WHERE elem LIKE $1 ESCAPE '\')`,
+			wantArgs: []any{`%50\%%`},
+		},
+		{
+			input:    `tags~[a, b]`,
+			want:     `EXISTS (SELECT 1 FROM unnest("tags") AS elem WHERE (elem LIKE $1 ESCAPE '\' OR elem LIKE $2 ESCAPE '\'))`,
+			wantArgs: []any{`%a%`, `%b%`},
+		},
+		{input: `tags? and name:x`, want: `("tags" IS NOT NULL AND "name" = $1)`, wantArgs: []any{"x"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			sql, args, err := renderer.ToSql(mustParse(t, test.input))
+			require.NoError(t, err)
+			assert.Equal(t, test.want, sql)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		for _, input := range []string{`name @> [a]`, `tags @> [1, a]`, `tags>[a, b]`} {
+			_, _, err := renderer.ToSql(mustParse(t, input))
+			require.Error(t, err, input)
+		}
+
+		_, _, err := query.SQLRenderer{Dialect: query.MySQL, Types: renderer.Types}.ToSql(mustParse(t, `tags:a`))
+		require.Error(t, err)
+
+		_, _, err = query.SQLRenderer{
+			Dialect:     query.Postgres,
+			JSONColumns: []string{"doc"},
+			Types:       schema.ColumnTypes{"doc.tags": {Array: true}},
+		}.ToSql(mustParse(t, `doc.tags:a`))
+		require.Error(t, err)
+
+		_, _, err = mustParse(t, `tags @> [a]`).ToSql()
+		require.Error(t, err)
+	})
+}
+
+func TestSQLRenderer_Types(t *testing.T) { //nolint:funlen
+	types := schema.ColumnTypes{
+		"id":      {SQL: "bigint"},
+		"user_id": {SQL: "uuid"},
+		"created": {SQL: "timestamptz"},
+		"mood":    {SQL: "mood"},
+		"name":    {SQL: "VARCHAR(64)"},
+		"moods":   {SQL: "mood", Array: true},
+		"ids":     {SQL: "int8", Array: true},
+	}
+
+	uuid := [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		dialect  query.Dialect
+		oneOf    query.OneOfMode
+		input    string
+		want     string
+		wantArgs []any
+	}{
+		{input: `id:42`, want: `"id" = $1`, wantArgs: []any{int64(42)}},
+		{input: `id>"7"`, want: `"id" > $1`, wantArgs: []any{int64(7)}},
+		{input: `id:[1, 2]`, want: `"id" IN ($1, $2)`, wantArgs: []any{int64(1), int64(2)}},
+		{input: `id:[1, 2]`, oneOf: query.OneOfArray, want: `"id" = ANY($1)`, wantArgs: []any{[]int64{1, 2}}},
+		{
+			input:    `user_id:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+			want:     `"user_id" = $1`,
+			wantArgs: []any{uuid},
+		},
+		{
+			dialect:  query.SQLServer,
+			input:    `user_id:"6BA7B8109DAD11D180B400C04FD430C8"`,
+			want:     `[user_id] = @p1`,
+			wantArgs: []any{"6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		},
+		{input: `created>="2024-03-01"`, want: `"created" >= $1`, wantArgs: []any{day}},
+		{
+			input:    `created<"2024-03-01T02:30:00+02:00"`,
+			want:     `"created" < $1`,
+			wantArgs: []any{time.Date(2024, 3, 1, 2, 30, 0, 0, time.FixedZone("", 2*60*60))},
+		},
+		{input: `mood:happy`, want: `"mood" = $1::mood`, wantArgs: []any{"happy"}},
+		{input: `mood!=[sad, ok]`, want: `"mood" NOT IN ($1::mood, $2::mood)`, wantArgs: []any{"sad", "ok"}},
+		{dialect: query.MySQL, input: `mood:happy`, want: "`mood` = ?", wantArgs: []any{"happy"}},
+		{
+			input:    `mood:[sad, ok]`,
+			oneOf:    query.OneOfArray,
+			want:     `"mood" = ANY($1::mood[])`,
+			wantArgs: []any{[]string{"sad", "ok"}},
+		},
+		{input: `moods:happy`, want: `$1::mood = ANY("moods")`, wantArgs: []any{"happy"}},
+		{input: `moods @> [happy]`, want: `"moods" @> $1::mood[]`, wantArgs: []any{[]string{"happy"}}},
+		{input: `ids:[1, 2]`, want: `"ids" && $1`, wantArgs: []any{[]int64{1, 2}}},
+		{input: `name~jo`, want: `"name" LIKE $1 ESCAPE '\'`, wantArgs: []any{"%jo%"}},
+		{input: `created?`, want: `"created" IS NOT NULL`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if test.dialect == 0 {
+				test.dialect = query.Postgres
+			}
+
+			renderer := query.SQLRenderer{Dialect: test.dialect, OneOf: test.oneOf, Types: types}
+			if test.dialect != query.Postgres {
+				renderer.Types = schema.ColumnTypes{"user_id": types["user_id"], "mood": types["mood"]}
+			}
+
+			sql, args, err := renderer.ToSql(mustParse(t, test.input))
+			require.NoError(t, err)
+			assert.Equal(t, test.want, sql)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		renderer := query.SQLRenderer{Dialect: query.Postgres, Types: types, JSONColumns: []string{"doc"}}
+
+		for _, input := range []string{
+			`id:1.5`,
+			`id:abc`,
+			`id:true`,
+			`id:100000000000000000000`,
+			`id~4`,
+			`ids:[1, 1.5]`,
+			`user_id:"6ba7b810-9dad-11d1-80b4"`,
+			`user_id:"6ba7b810+9dad+11d1+80b4+00c04fd430c8"`,
+			`user_id:"zba7b8109dad11d180b400c04fd430c8"`,
+			`user_id:1`,
+			`created>yesterday`,
+			`created>1`,
+			`mood:1`,
+			`mood~ha`,
+		} {
+			_, _, err := renderer.ToSql(mustParse(t, input))
+			require.Error(t, err, input)
+		}
+
+		renderer.Types = schema.ColumnTypes{"doc.id": {SQL: "bigint"}}
+		_, _, err := renderer.ToSql(mustParse(t, `doc.id:1`))
+		require.Error(t, err)
+	})
+}
@@ -57,6 +57,18 @@ func TestToSql(t *testing.T) { //nolint:funlen
 			want:     "SELECT * FROM dummy_table WHERE req.fields.ext IN (?,?)",
 			wantArgs: []any{"jpg", "png"},
 		},
+		{
+			// Empty array literal never matches.
+			input:    "ext:[]",
+			want:     "SELECT * FROM dummy_table WHERE (1=0)",
+			wantArgs: []any{},
+		},
+		{
+			// Empty array literal with not equals always matches.
+			input:    "ext!=[]",
+			want:     "SELECT * FROM dummy_table WHERE (1=1)",
+			wantArgs: []any{},
+		},
 		{
 			// Complex expression combining AND and a parenthesized array literal.
 			input:    "status:200 and eps<0.003 and (req.fields.ext:[\"jpg\", \"png\"])",
@@ -83,11 +95,23 @@ func TestToSql(t *testing.T) { //nolint:funlen
 		},
 		{
 			input: `name~"John"`,
-			want:  "SELECT * FROM dummy_table WHERE name LIKE ?",
+			want:  `SELECT * FROM dummy_table WHERE name LIKE ? ESCAPE '\'`,
 			wantArgs: []any{
-				"John",
+				"%John%",
 			},
 		},
+		{
+			// LIKE wildcards in the value are matched literally
+			input:    `discount~"50%_\\"`,
+			want:     `SELECT * FROM dummy_table WHERE discount LIKE ? ESCAPE '\'`,
+			wantArgs: []any{`%50\%\_\\%`},
+		},
+		{
+			// LIKE with one-of values matches any of them
+			input:    `name~[jo, an]`,
+			want:     `SELECT * FROM dummy_table WHERE (name LIKE ? ESCAPE '\' OR name LIKE ? ESCAPE '\')`,
+			wantArgs: []any{"%jo%", "%an%"},
+		},
 		{
 			// Boolean true value
 			input:    "is_active:true",
@@ -142,6 +166,18 @@ func TestToSql(t *testing.T) { //nolint:funlen
 			want:     "SELECT * FROM dummy_table WHERE (name IS NOT NULL AND (age > ? OR active = ?))",
 			wantArgs: []any{float64(20), true},
 		},
+		{
+			// Quoted field name is rendered as a single quoted identifier
+			input:    "labels.`app.kubernetes.io/name`:web",
+			want:     `SELECT * FROM dummy_table WHERE labels."app.kubernetes.io/name" = ?`,
+			wantArgs: []any{"web"},
+		},
+		{
+			// Quoted field name with field presence operator
+			input:    "`x-request-id`?",
+			want:     `SELECT * FROM dummy_table WHERE "x-request-id" IS NOT NULL`,
+			wantArgs: []any{},
+		},
 	}
 
 	for _, test := range tests {
@@ -160,3 +196,44 @@ func TestToSql(t *testing.T) { //nolint:funlen
 		})
 	}
 }
+
+func TestLikeMode_Sqlizer(t *testing.T) {
+	tests := []struct {
+		input    string
+		mode     query.LikeMode
+		want     string
+		wantArgs []any
+	}{
+		{
+			input:    `name~jo`,
+			mode:     query.LikeContains,
+			want:     `name LIKE ? ESCAPE '\'`,
+			wantArgs: []any{"%jo%"},
+		},
+		{
+			input:    `name~"50%"`,
+			mode:     query.LikePrefix,
+			want:     `name LIKE ? ESCAPE '\'`,
+			wantArgs: []any{`50\%%`},
+		},
+		{
+			// The mode applies to every `~` of the expression
+			input:    `age > 18 and not (name~[jo, an] or city~MAD)`,
+			mode:     query.LikeSuffix,
+			want:     `(age > ? AND NOT ((name LIKE ? ESCAPE '\' OR name LIKE ? ESCAPE '\') OR city LIKE ? ESCAPE '\'))`,
+			wantArgs: []any{float64(18), "%jo", "%an", "%MAD"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mode.String()+": "+test.input, func(t *testing.T) {
+			expr, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			got, gotArgs, err := test.mode.Sqlizer(expr.(query.Expr)).ToSql()
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+			require.Equal(t, test.wantArgs, gotArgs)
+		})
+	}
+}
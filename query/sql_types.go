@@ -0,0 +1,167 @@
+package query
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sqlKind defines how values compared with a column of an SQL type are bound.
+type sqlKind uint8
+
+const (
+	anyKind     sqlKind = iota // Values are bound as parsed: text, numeric and boolean types
+	integerKind                // Values are bound as int64
+	uuidKind                   // Values are bound as [16]byte on Postgres and as canonical strings elsewhere
+	timeKind                   // Values are bound as time.Time
+	castKind                   // Values are bound as strings and cast to the type on Postgres, e.g. enums
+)
+
+var sqlKinds = map[string]sqlKind{
+	"smallint": integerKind, "integer": integerKind, "int": integerKind, "bigint": integerKind,
+	"int2": integerKind, "int4": integerKind, "int8": integerKind, "tinyint": integerKind,
+	"mediumint": integerKind, "smallserial": integerKind, "serial": integerKind, "bigserial": integerKind,
+
+	"uuid": uuidKind, "uniqueidentifier": uuidKind,
+
+	"timestamp": timeKind, "timestamptz": timeKind, "timestamp with time zone": timeKind,
+	"timestamp without time zone": timeKind, "date": timeKind, "datetime": timeKind, "datetime2": timeKind,
+	"datetimeoffset": timeKind, "smalldatetime": timeKind,
+
+	"text": anyKind, "varchar": anyKind, "character varying": anyKind, "char": anyKind, "character": anyKind,
+	"nvarchar": anyKind, "nchar": anyKind, "citext": anyKind, "numeric": anyKind, "decimal": anyKind,
+	"real": anyKind, "double precision": anyKind, "double": anyKind, "float": anyKind, "float4": anyKind,
+	"float8": anyKind, "boolean": anyKind, "bool": anyKind, "bit": anyKind,
+}
+
+// sqlKindOf returns the kind of the SQL type. Type parameters and case are ignored, e.g. VARCHAR(64) is text.
+// Unknown types are assumed to be user-defined, like enums.
+func sqlKindOf(typ string) sqlKind {
+	if typ == "" {
+		return anyKind
+	}
+
+	name, _, _ := strings.Cut(strings.ToLower(typ), "(")
+
+	kind, ok := sqlKinds[strings.TrimSpace(name)]
+	if !ok {
+		return castKind
+	}
+
+	return kind
+}
+
+// timeLayouts are the layouts of the strings compared with time columns, the first matching one wins.
+// Times without a zone are in UTC.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
+// convertValue converts the value to the Go type bound for a column of the SQL type.
+func (d Dialect) convertValue(typ string, v Valuer) (any, error) {
+	switch sqlKindOf(typ) {
+	case integerKind:
+		return toInt64(v)
+	case uuidKind:
+		s, ok := v.Value().(string)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a UUID", v.Value())
+		}
+
+		u, err := parseUUID(s)
+		if err != nil || d == Postgres {
+			return u, err
+		}
+
+		return formatUUID(u), nil
+	case timeKind:
+		s, ok := v.Value().(string)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a time", v.Value())
+		}
+
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+
+		return nil, fmt.Errorf("%q is not a time", s)
+	case castKind:
+		s, ok := v.Value().(string)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a string", v.Value())
+		}
+
+		return s, nil
+	default:
+		return v.Value(), nil
+	}
+}
+
+func toInt64(v Valuer) (int64, error) {
+	switch val := v.Value().(type) {
+	case float64:
+		if val != math.Trunc(val) || val < math.MinInt64 || val >= math.MaxInt64 {
+			return 0, fmt.Errorf("%v is not an integer", val)
+		}
+
+		return int64(val), nil
+	case string:
+		n, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not an integer", val)
+		}
+
+		return n, nil
+	default:
+		return 0, fmt.Errorf("%v is not an integer", val)
+	}
+}
+
+// parseUUID parses UUIDs in the canonical form, e.g. 6ba7b810-9dad-11d1-80b4-00c04fd430c8, or without dashes.
+func parseUUID(s string) ([16]byte, error) {
+	var u [16]byte
+
+	digits := s
+
+	const canonicalLen = 36
+	if len(s) == canonicalLen && s[8] == '-' && s[13] == '-' && s[18] == '-' && s[23] == '-' {
+		digits = s[:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	}
+
+	if len(digits) != hex.EncodedLen(len(u)) {
+		return u, fmt.Errorf("%q is not a UUID", s)
+	}
+
+	if _, err := hex.Decode(u[:], []byte(digits)); err != nil {
+		return u, fmt.Errorf("%q is not a UUID", s)
+	}
+
+	return u, nil
+}
+
+func formatUUID(u [16]byte) string {
+	s := hex.EncodeToString(u[:])
+	return s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+// cast renders the cast of a placeholder to a user-defined type, e.g. `::mood`, or `::mood[]` for arrays.
+// Only Postgres needs it, other databases compare enums with strings.
+func (d Dialect) cast(typ string, array bool) string {
+	if d != Postgres || sqlKindOf(typ) != castKind {
+		return ""
+	}
+
+	if array {
+		return "::" + typ + "[]"
+	}
+
+	return "::" + typ
+}
@@ -9,6 +9,7 @@ import (
 
 // Validate checks if the binary expression is valid against the schema.
 // If either the left or right expression is invalid, the only valid expression is returned.
+// The result never shares nodes with the input, see Expr.
 func (b *BinaryExpr) Validate(schema schema.Schema) (Expr, error) {
 	left, err := b.Left.Validate(schema)
 
@@ -42,7 +43,7 @@ func (n *NotExpr) Validate(schema schema.Schema) (Expr, error) {
 		return expr, err
 	}
 
-	return n, nil
+	return &NotExpr{Expr: expr}, nil
 }
 
 // Validate checks if the field expression is valid against the corresponding schema rule.
@@ -59,7 +60,7 @@ func (f *FieldExpr) Validate(schm schema.Schema) (Expr, error) {
 		if err := rule(field, f.Value.Value()); err != nil {
 			return nil, err
 		}
-		return f, nil
+		return &FieldExpr{Field: f.Field, Op: f.Op, Value: CloneValue(f.Value)}, nil
 	}
 
 	var (
@@ -72,7 +73,7 @@ func (f *FieldExpr) Validate(schm schema.Schema) (Expr, error) {
 			err = multierr.Append(err, ruleErr)
 			continue
 		}
-		values = append(values, v)
+		values = append(values, CloneValue(v))
 	}
 
 	return &FieldExpr{
@@ -364,3 +364,31 @@ func TestFieldExpr_Validate(t *testing.T) { //nolint:funlen
 func ruleError(schema.Field, any) error {
 	return errors.New("rule error")
 }
+
+func TestValidate_DoesNotAliasInput(t *testing.T) {
+	schm := schema.Schema{
+		"a":    schema.Any(),
+		"b":    schema.Any(),
+		"tags": schema.EqualsOneOf("web", "api"),
+	}
+
+	tests := []string{
+		`a:1`,
+		`not a:x`,
+		`a:1 and not (b:true or tags:[web, api])`,
+		`a:1 and unknown:2`,
+		`not tags:[web, cli]`,
+	}
+
+	for _, q := range tests {
+		t.Run(q, func(t *testing.T) {
+			expr := mustParse(t, q)
+			want := expr.String()
+
+			got, _ := expr.Validate(schm)
+			require.NotNil(t, got)
+			assertNoSharedNodes(t, expr, got)
+			assert.Equal(t, want, expr.String())
+		})
+	}
+}
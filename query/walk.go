@@ -0,0 +1,239 @@
+package query
+
+import "fmt"
+
+// Node is a node of the query AST: an Expr, a Valuer or the Identifier of a FieldExpr.
+type Node any
+
+// A Visitor's Visit method is invoked for each node encountered by Walk.
+// If the result visitor w is not nil, Walk visits each of the children
+// of node with the visitor w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk traverses the AST in depth-first order: It starts by calling v.Visit(node); node must not be nil.
+// If the visitor w returned by v.Visit(node) is not nil, Walk is invoked recursively with visitor w
+// for each of the non-nil children of node, followed by a call of w.Visit(nil).
+//
+// Children are visited in source order: the operands of a BinaryExpr, the operand of a NotExpr,
+// the field and the value of a FieldExpr and the values of a OneOfExpr. Literals and unknown node types
+// have no children.
+func Walk(v Visitor, node Node) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+
+	for _, child := range children(node) {
+		Walk(v, child)
+	}
+
+	v.Visit(nil)
+}
+
+type inspector func(Node) bool
+
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses the AST in depth-first order: It starts by calling f(node); node must not be nil.
+// If f returns true, Inspect invokes f recursively for each of the non-nil children of node,
+// followed by a call of f(nil).
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
+
+// children returns the non-nil children of the node in source order.
+func children(node Node) []Node {
+	var nodes []Node
+
+	appendNode := func(n Node) {
+		if !isNil(n) {
+			nodes = append(nodes, n)
+		}
+	}
+
+	switch n := node.(type) {
+	case *BinaryExpr:
+		if n != nil {
+			appendNode(n.Left)
+			appendNode(n.Right)
+		}
+	case *NotExpr:
+		if n != nil {
+			appendNode(n.Expr)
+		}
+	case *FieldExpr:
+		if n != nil {
+			appendNode(n.Field)
+			appendNode(n.Value)
+		}
+	case *OneOfExpr:
+		if n != nil {
+			for _, v := range n.Values {
+				appendNode(v)
+			}
+		}
+	}
+
+	return nodes
+}
+
+// isNil reports whether the node is nil or a typed nil pointer to one of the AST node types.
+func isNil(node any) bool {
+	switch n := node.(type) {
+	case nil:
+		return true
+	case *BinaryExpr:
+		return n == nil
+	case *NotExpr:
+		return n == nil
+	case *FieldExpr:
+		return n == nil
+	case *OneOfExpr:
+		return n == nil
+	case *StringLiteral:
+		return n == nil
+	case *NumberLiteral:
+		return n == nil
+	case *BoolLiteral:
+		return n == nil
+	default:
+		return false
+	}
+}
+
+// Rewrite traverses the AST in depth-first order and replaces every node with the result of fn.
+// Unlike Walk, fn is called after the children of the node have been rewritten, so it sees the node
+// with its rewritten children.
+//
+// Rewrite never modifies the input tree: when any child of a node is replaced, the node is copied,
+// while unchanged subtrees are shared between the input and the result.
+//
+// Returning nil from fn removes the node: a BinaryExpr with one removed operand is replaced with
+// the other operand, a NotExpr or FieldExpr with a removed child is removed as well and removed values
+// are dropped from one-of lists. The replacement must fit the position of the node, i.e. an Expr for
+// expressions, a Valuer for values and an Identifier for field names, otherwise Rewrite panics.
+func Rewrite(node Node, fn func(Node) Node) Node {
+	if isNil(node) {
+		return nil
+	}
+
+	switch n := node.(type) {
+	case *BinaryExpr:
+		res, collapsed := rewriteBinary(n, fn)
+		if collapsed {
+			// The remaining operand has already been rewritten.
+			return res
+		}
+		node = res
+	case *NotExpr:
+		node = rewriteNot(n, fn)
+	case *FieldExpr:
+		node = rewriteField(n, fn)
+	case *OneOfExpr:
+		node = rewriteOneOf(n, fn)
+	}
+
+	if isNil(node) {
+		return nil
+	}
+
+	return fn(node)
+}
+
+// RewriteExpr is like Rewrite, but takes and returns an Expr.
+func RewriteExpr(expr Expr, fn func(Node) Node) Expr {
+	return rewriteAs[Expr](expr, fn, "expression")
+}
+
+func rewriteAs[T any](node Node, fn func(Node) Node, position string) T {
+	var zero T
+
+	res := Rewrite(node, fn)
+	if isNil(res) {
+		return zero
+	}
+
+	typed, ok := res.(T)
+	if !ok {
+		panic(fmt.Sprintf("query.Rewrite: %T can't be used as %s", res, position))
+	}
+
+	return typed
+}
+
+// rewriteBinary rewrites the operands of b. It reports whether b collapsed to one of its operands
+// because the other one was removed.
+func rewriteBinary(b *BinaryExpr, fn func(Node) Node) (Node, bool) {
+	left := rewriteAs[Expr](b.Left, fn, "expression")
+	right := rewriteAs[Expr](b.Right, fn, "expression")
+
+	switch {
+	case isNil(left) && isNil(right):
+		return nil, true
+	case isNil(left):
+		return right, true
+	case isNil(right):
+		return left, true
+	case left == b.Left && right == b.Right:
+		return b, false
+	default:
+		return &BinaryExpr{Left: left, Op: b.Op, Right: right}, false
+	}
+}
+
+func rewriteNot(n *NotExpr, fn func(Node) Node) Node {
+	expr := rewriteAs[Expr](n.Expr, fn, "expression")
+
+	switch {
+	case isNil(expr):
+		return nil
+	case expr == n.Expr:
+		return n
+	default:
+		return &NotExpr{Expr: expr}
+	}
+}
+
+func rewriteField(f *FieldExpr, fn func(Node) Node) Node {
+	field := rewriteAs[Identifier](f.Field, fn, "field name")
+	value := rewriteAs[Valuer](f.Value, fn, "value")
+
+	switch {
+	case (field == "" && f.Field != "") || (isNil(value) && !isNil(f.Value)):
+		return nil
+	case field == f.Field && value == f.Value:
+		return f
+	default:
+		return &FieldExpr{Field: field, Op: f.Op, Value: value}
+	}
+}
+
+func rewriteOneOf(o *OneOfExpr, fn func(Node) Node) Node {
+	var (
+		values  = make([]Valuer, 0, len(o.Values))
+		changed bool
+	)
+
+	for _, v := range o.Values {
+		res := rewriteAs[Valuer](v, fn, "value")
+		if res != v {
+			changed = true
+		}
+
+		if !isNil(res) {
+			values = append(values, res)
+		}
+	}
+
+	if !changed {
+		return o
+	}
+
+	return &OneOfExpr{Values: values}
+}
@@ -0,0 +1,253 @@
+package query_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.tomakado.io/dumbql/query"
+)
+
+func mustParse(t testing.TB, q string) query.Expr {
+	t.Helper()
+
+	ast, err := query.Parse("test", []byte(q))
+	require.NoError(t, err)
+
+	return ast.(query.Expr)
+}
+
+type recordingVisitor struct {
+	nodes []string
+}
+
+func (v *recordingVisitor) Visit(node query.Node) query.Visitor {
+	if node == nil {
+		v.nodes = append(v.nodes, "<end>")
+		return nil
+	}
+
+	v.nodes = append(v.nodes, fmt.Sprintf("%T %v", node, node))
+
+	return v
+}
+
+func TestWalk(t *testing.T) {
+	expr := mustParse(t, `a:1 and not b:[x, 2]`)
+
+	v := &recordingVisitor{}
+	query.Walk(v, expr)
+
+	assert.Equal(t, []string{
+		`*query.BinaryExpr (and (= a 1) (not (= b ["x" 2])))`,
+		`*query.FieldExpr (= a 1)`,
+		`query.Identifier a`,
+		`<end>`,
+		`*query.NumberLiteral 1`,
+		`<end>`,
+		`<end>`,
+		`*query.NotExpr (not (= b ["x" 2]))`,
+		`*query.FieldExpr (= b ["x" 2])`,
+		`query.Identifier b`,
+		`<end>`,
+		`*query.OneOfExpr ["x" 2]`,
+		`*query.StringLiteral "x"`,
+		`<end>`,
+		`*query.NumberLiteral 2`,
+		`<end>`,
+		`<end>`,
+		`<end>`,
+		`<end>`,
+		`<end>`,
+	}, v.nodes)
+}
+
+func TestInspect(t *testing.T) {
+	expr := mustParse(t, `a:1 and (b:2 or not c:3) and d?`)
+
+	var fields []string
+	query.Inspect(expr, func(node query.Node) bool {
+		switch n := node.(type) {
+		case *query.FieldExpr:
+			fields = append(fields, n.Field.String())
+			return false
+		case *query.NotExpr:
+			return false // Skip negated subtrees
+		default:
+			return true
+		}
+	})
+
+	assert.Equal(t, []string{"a", "b", "d"}, fields)
+}
+
+func TestInspect_NilChildren(t *testing.T) {
+	var visited int
+
+	query.Inspect(&query.BinaryExpr{Left: (*query.FieldExpr)(nil), Op: query.And}, func(node query.Node) bool {
+		if node != nil {
+			visited++
+		}
+		return true
+	})
+
+	assert.Equal(t, 1, visited)
+}
+
+func TestRewrite(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		name  string
+		input string
+		fn    func(query.Node) query.Node
+		want  string
+	}{
+		{
+			name:  "identity keeps the tree",
+			input: `a:1 and not b:[x, 2]`,
+			fn:    func(n query.Node) query.Node { return n },
+			want:  `(and (= a 1) (not (= b ["x" 2])))`,
+		},
+		{
+			name:  "rename fields",
+			input: `user.email:"x" or not user.name~j`,
+			fn: func(n query.Node) query.Node {
+				if id, ok := n.(query.Identifier); ok {
+					return query.Identifier(strings.Replace(string(id), "user.", "u.", 1))
+				}
+				return n
+			},
+			want: `(or (= u.email "x") (not (~ u.name "j")))`,
+		},
+		{
+			name:  "drop field expressions",
+			input: `a:1 and (secret:2 or b:3) and not secret:4`,
+			fn: func(n query.Node) query.Node {
+				if f, ok := n.(*query.FieldExpr); ok && f.Field == "secret" {
+					return nil
+				}
+				return n
+			},
+			want: `(and (= a 1) (= b 3))`,
+		},
+		{
+			name:  "drop one-of values",
+			input: `status:[a, b, c]`,
+			fn: func(n query.Node) query.Node {
+				if s, ok := n.(*query.StringLiteral); ok && s.StringValue == "b" {
+					return nil
+				}
+				return n
+			},
+			want: `(= status ["a" "c"])`,
+		},
+		{
+			name:  "replace values",
+			input: `age >= 18 and score:[1, 2]`,
+			fn: func(n query.Node) query.Node {
+				if num, ok := n.(*query.NumberLiteral); ok {
+					return &query.NumberLiteral{NumberValue: num.NumberValue * 10}
+				}
+				return n
+			},
+			want: `(and (>= age 180) (= score [10 20]))`,
+		},
+		{
+			name:  "children are rewritten before parents",
+			input: `not not_a:1`,
+			fn: func(n query.Node) query.Node {
+				if not, ok := n.(*query.NotExpr); ok {
+					if f, ok := not.Expr.(*query.FieldExpr); ok && f.Op == query.Equal {
+						return &query.FieldExpr{Field: f.Field, Op: query.NotEqual, Value: f.Value}
+					}
+				}
+				return n
+			},
+			want: `(!= not_a 1)`,
+		},
+		{
+			name:  "drop everything",
+			input: `a:1 or b:2`,
+			fn: func(n query.Node) query.Node {
+				if _, ok := n.(*query.FieldExpr); ok {
+					return nil
+				}
+				return n
+			},
+			want: `<nil>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expr := mustParse(t, test.input)
+			before := expr.String()
+
+			got := query.RewriteExpr(expr, test.fn)
+
+			assert.Equal(t, test.want, fmt.Sprint(got))
+			assert.Equal(t, before, expr.String(), "input tree must not be modified")
+		})
+	}
+}
+
+func TestRewrite_SharesUnchangedSubtrees(t *testing.T) {
+	expr := mustParse(t, `(a:1 and b:2) or c:3`).(*query.BinaryExpr)
+
+	got := query.RewriteExpr(expr, func(n query.Node) query.Node {
+		if f, ok := n.(*query.FieldExpr); ok && f.Field == "c" {
+			return &query.FieldExpr{Field: "d", Op: f.Op, Value: f.Value}
+		}
+		return n
+	}).(*query.BinaryExpr)
+
+	assert.NotSame(t, expr, got)
+	assert.Same(t, expr.Left, got.Left)
+	assert.Equal(t, "(= d 3)", got.Right.String())
+
+	same := query.RewriteExpr(expr, func(n query.Node) query.Node { return n })
+	assert.Same(t, expr, same)
+}
+
+func TestRewrite_CollapsedOperandsAreRewrittenOnce(t *testing.T) {
+	var calls int
+
+	got := query.RewriteExpr(mustParse(t, `a:1 and b:2`), func(n query.Node) query.Node {
+		f, ok := n.(*query.FieldExpr)
+		if !ok {
+			return n
+		}
+
+		calls++
+		if f.Field == "b" {
+			return nil
+		}
+		return &query.FieldExpr{Field: f.Field + "_x", Op: f.Op, Value: f.Value}
+	})
+
+	assert.Equal(t, "(= a_x 1)", got.String())
+	assert.Equal(t, 2, calls)
+}
+
+func TestRewrite_Panics(t *testing.T) {
+	expr := mustParse(t, `a:1`)
+
+	assert.Panics(t, func() {
+		query.RewriteExpr(expr, func(n query.Node) query.Node {
+			if _, ok := n.(*query.NumberLiteral); ok {
+				return &query.NotExpr{}
+			}
+			return n
+		})
+	})
+
+	assert.Panics(t, func() {
+		query.RewriteExpr(expr, func(n query.Node) query.Node {
+			if _, ok := n.(*query.FieldExpr); ok {
+				return query.Identifier("a")
+			}
+			return n
+		})
+	})
+}
@@ -0,0 +1,14 @@
+package schema
+
+// ColumnType describes the SQL column a field is stored in.
+type ColumnType struct {
+	// SQL is the type of the column, or of its elements for arrays, e.g. "bigint", "uuid", "timestamptz"
+	// or the name of an enum type. Values are converted to the Go type of the column before binding,
+	// so comparisons use the indexes of the column.
+	SQL string
+
+	Array bool // Whether the column is a Postgres array, e.g. text[]
+}
+
+// ColumnTypes is a set of Field to ColumnType pairs which defines how fields are rendered into SQL.
+type ColumnTypes map[Field]ColumnType
@@ -0,0 +1,10 @@
+package schema
+
+// FieldCost describes how expensive filtering by a field is.
+type FieldCost struct {
+	Weight  float64 // Relative cost of a single comparison, 1 if zero
+	Indexed bool    // Whether the field is backed by an index
+}
+
+// Costs is a set of Field to FieldCost pairs used for query cost estimation.
+type Costs map[Field]FieldCost